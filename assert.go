@@ -1,118 +1,565 @@
 package bee
 
 import (
+	"cmp"
 	"fmt"
-	"math"
 	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
 	"strings"
 	"testing"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/rivo/uniseg"
 )
 
-func (b *Bee) Nil(actual any) {
+func (b *Bee) Nil(actual any, msgAndArgs ...any) bool {
 	b.tb.Helper()
-	if !isNil(b.tb, actual) {
+	b = b.annotate(msgAndArgs)
+	ok := isNil(b.tb, actual)
+	if !ok {
 		b.errorNotEquals(actual, nil, "")
 	}
+	return b.done(ok)
 }
 
-func (b *Bee) NotNil(actual any) {
+func (b *Bee) NotNil(actual any, msgAndArgs ...any) bool {
 	b.tb.Helper()
-	if isNil(b.tb, actual) {
+	b = b.annotate(msgAndArgs)
+	ok := !isNil(b.tb, actual)
+	if !ok {
 		b.errorEquals(actual, nil, "")
 	}
+	return b.done(ok)
 }
 
-func (b *Bee) True(actual bool) {
+func (b *Bee) True(actual bool, msgAndArgs ...any) bool {
 	b.tb.Helper()
-	b.Equal(actual, true)
+	return b.Equal(actual, true, msgAndArgs...)
 }
 
-func (b *Bee) False(actual bool) {
+func (b *Bee) False(actual bool, msgAndArgs ...any) bool {
 	b.tb.Helper()
-	b.Equal(actual, false)
+	return b.Equal(actual, false, msgAndArgs...)
 }
 
-func (b *Bee) Equal(actual, expected any) {
+func (b *Bee) Equal(actual, expected any, msgAndArgs ...any) bool {
 	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
 	actualValue := reflect.ValueOf(actual)
 	expectedValue := reflect.ValueOf(expected)
-	b.equals(actualValue, expectedValue, "")
+	return b.done(b.compare(actualValue, expectedValue, false))
 }
 
-func (b *Bee) equals(actual, expected reflect.Value, what string) {
+// Matches checks that actual matches the parts of expected that are set:
+// the zero values in expected, such as unset struct fields, and the keys
+// missing from its maps match anything.
+func (b *Bee) Matches(actual, expected any, msgAndArgs ...any) bool {
 	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	c := b.newComparison(false)
+	c.partial = true
+	return b.done(c.equals(reflect.ValueOf(actual), reflect.ValueOf(expected), ""))
+}
+
+func (b *Bee) NotEqual(actual, expected any, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	actualValue := reflect.ValueOf(actual)
+	expectedValue := reflect.ValueOf(expected)
+	ok := !b.compare(actualValue, expectedValue, true)
+	if !ok {
+		b.errorEquals(actual, expected, "")
+	}
+	return b.done(ok)
+}
+
+// done is called at the end of every assertion with its outcome, and stops
+// the test after a failure if fail fast is enabled. It returns the outcome.
+func (b *Bee) done(ok bool) bool {
+	b.tb.Helper()
+	if !ok && b.cfg.failFast {
+		b.tb.FailNow()
+	}
+	return ok
+}
+
+// compare walks actual and expected and reports whether they are deeply equal.
+// In quiet mode the walk stops at the first difference without reporting it.
+func (b *Bee) compare(actual, expected reflect.Value, quiet bool) bool {
+	b.tb.Helper()
+	return b.newComparison(quiet).equals(actual, expected, "")
+}
+
+func (b *Bee) newComparison(quiet bool) *comparison {
+	return &comparison{
+		b:             b,
+		quiet:         quiet,
+		actualStack:   map[ref]string{},
+		expectedStack: map[ref]string{},
+		drained:       map[uintptr]drained{},
+		visited:       map[visit]bool{},
+	}
+}
+
+type comparison struct {
+	b     *Bee
+	quiet bool
+	// redact masks the values in failures, below a field tagged with redact.
+	redact bool
+	// partial makes the zero values and the missing map keys of expected
+	// match anything.
+	partial bool
+	// actualStack and expectedStack hold the references that are being
+	// compared on the current path, mapped to where they were first seen.
+	actualStack   map[ref]string
+	expectedStack map[ref]string
+	// visited holds the pairs of references that were found equal, so that
+	// shared substructures are compared only once.
+	visited map[visit]bool
+	// drained holds the channels that were drained, so that each channel is
+	// drained at most once even if it is compared more than once.
+	drained map[uintptr]drained
+}
+
+type ref struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
+
+// visit is a pair of references compared by a Bee, whose options decide
+// whether they are equal.
+type visit struct {
+	actual   ref
+	expected ref
+	b        *Bee
+}
+
+func refOf(v reflect.Value) (ref, bool) {
+	switch v.Kind() {
+	case reflect.Map, reflect.Pointer:
+		return ref{ptr: v.Pointer(), typ: v.Type()}, !v.IsNil()
+	case reflect.Slice:
+		return ref{ptr: v.Pointer(), typ: v.Type(), len: v.Len()}, !v.IsNil()
+	}
+	return ref{}, false
+}
+
+func (c *comparison) equals(actual, expected reflect.Value, what string) (ok bool) {
+	c.b.tb.Helper()
+	if c.b.cfg.ignoredPath(what) {
+		return true
+	}
+	if c.partial && (!expected.IsValid() || expected.IsZero()) {
+		return true
+	}
 	if !actual.IsValid() || !expected.IsValid() {
 		if actual.IsValid() != expected.IsValid() {
-			b.errorNotEquals(actual, expected, what)
+			return c.errorNotEquals(actual, expected, what)
 		}
-		return
+		return true
 	}
 	if actual.Type() != expected.Type() {
-		b.errorNotEquals(actual.Type(), expected.Type(), what)
-		return
+		return c.errorNotEquals(actual.Type(), expected.Type(), what)
+	}
+	if c.b.cfg.ignoredType(actual.Type()) {
+		return true
+	}
+	if equal, ok := c.compareWith(actual, expected, what); ok {
+		return equal
 	}
+	actualRef, actualIsRef := refOf(actual)
+	expectedRef, expectedIsRef := refOf(expected)
+	if actualIsRef && expectedIsRef {
+		actualSeen, actualCycle := c.actualStack[actualRef]
+		expectedSeen, expectedCycle := c.expectedStack[expectedRef]
+		if actualCycle || expectedCycle {
+			if actualCycle && expectedCycle && actualSeen == expectedSeen {
+				return true
+			}
+			return c.errorNotEquals(cycleTo(actual, actualSeen, actualCycle), cycleTo(expected, expectedSeen, expectedCycle), what)
+		}
+		key := visit{actual: actualRef, expected: expectedRef, b: c.b}
+		if c.visited[key] {
+			return true
+		}
+		c.actualStack[actualRef] = what
+		c.expectedStack[expectedRef] = what
+		defer delete(c.actualStack, actualRef)
+		defer delete(c.expectedStack, expectedRef)
+		// The paths decide which values are ignored, so the pairs can only be
+		// reused without ignored paths.
+		if len(c.b.cfg.ignoredPaths) == 0 {
+			defer func() {
+				if ok {
+					c.visited[key] = true
+				}
+			}()
+		}
+	}
+	ok = true
 	switch actual.Kind() {
 	case reflect.Bool:
 		if actual.Bool() != expected.Bool() {
-			b.errorNotEquals(actual.Bool(), expected.Bool(), what)
+			return c.errorNotEquals(actual.Bool(), expected.Bool(), what)
 		}
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		if actual.Int() != expected.Int() {
-			b.errorNotEquals(actual.Int(), expected.Int(), what)
+			return c.errorNotEquals(actual.Int(), expected.Int(), what)
 		}
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
 		if actual.Uint() != expected.Uint() {
-			b.errorNotEquals(actual.Uint(), expected.Uint(), what)
+			return c.errorNotEquals(actual.Uint(), expected.Uint(), what)
 		}
 	case reflect.Float32, reflect.Float64:
-		if math.Abs(actual.Float()-expected.Float()) > 1e-9 {
-			b.errorNotEquals(actual.Float(), expected.Float(), what)
+		if !floatEqual(actual.Float(), expected.Float(), actual.Type().Bits(), c.b.cfg.tolerance, c.b.cfg.nanEqual) {
+			return c.errorNotEquals(actual.Float(), expected.Float(), what)
 		}
 	case reflect.Complex64, reflect.Complex128:
-		if actual.Complex() != expected.Complex() {
-			b.errorNotEquals(actual.Complex(), expected.Complex(), what)
+		if !complexEqual(actual.Complex(), expected.Complex(), actual.Type().Bits(), c.b.cfg.tolerance, c.b.cfg.nanEqual) {
+			return c.errorNotEquals(actual.Complex(), expected.Complex(), what)
 		}
 	case reflect.String:
 		if actual.String() != expected.String() {
-			b.errorNotEquals(actual.String(), expected.String(), what)
+			return c.errorNotEquals(actual.String(), expected.String(), what)
 		}
 	case reflect.Interface:
-		b.equals(actual.Elem(), expected.Elem(), what)
+		return c.equals(actual.Elem(), expected.Elem(), what)
 	case reflect.Array, reflect.Slice:
-		if actual.Len() != expected.Len() {
-			b.errorNotEquals(actual.Len(), expected.Len(), fmt.Sprintf("len(%s)", what))
-			return
-		}
-		for i := 0; i < actual.Len(); i++ {
-			b.equals(actual.Index(i), expected.Index(i), fmt.Sprintf("%s[%d]", what, i))
-		}
+		return c.slices(actual, expected, what)
 	case reflect.Map:
-		if actual.Len() != expected.Len() {
-			b.errorNotEquals(actual.Len(), expected.Len(), fmt.Sprintf("len(%s)", what))
-			return
-		}
-		for _, k := range actual.MapKeys() {
-			b.equals(actual.MapIndex(k), expected.MapIndex(k), fmt.Sprintf("%s[%s]", what, k))
-		}
+		return c.maps(actual, expected, what)
 	case reflect.Struct:
-		for i := 0; i < actual.NumField(); i++ {
-			b.equals(
-				actual.Field(i),
-				expected.Field(i),
-				fmt.Sprintf("%s.%s", what, actual.Type().Field(i).Name),
-			)
+		for i := 0; i < actual.NumField() && (ok || !c.quiet); i++ {
+			field := actual.Type().Field(i)
+			fieldWhat := fmt.Sprintf("%s.%s", what, field.Name)
+			tag, err := parseTag(field)
+			if err != nil {
+				if !c.quiet {
+					c.b.errorf(fieldWhat, "%v", err)
+				}
+				ok = false
+				continue
+			}
+			if tag.skip {
+				continue
+			}
+			ok = c.field(tag).equals(actual.Field(i), expected.Field(i), fieldWhat) && ok
 		}
 	case reflect.Chan:
-		// TODO: TryRecv until OK on both and compare the two read values, then TryRecv one more time to check whether both are finished
+		return c.chans(actual, expected, what)
 	case reflect.Func:
-		// TODO: Pointer()?: func: not necessarily unique, runtime.FuncForPC to get func name from pointer
+		return c.funcs(actual, expected, what)
 	case reflect.Pointer:
-		b.equals(actual.Elem(), expected.Elem(), fmt.Sprintf("*%s", what))
+		return c.equals(actual.Elem(), expected.Elem(), fmt.Sprintf("*%s", what))
 	case reflect.UnsafePointer:
 		// TODO: Pointer()?
 	}
+	return ok
+}
+
+// slices compares the elements of slices and arrays. If they differ, the
+// elements are aligned by their longest common subsequence, so that
+// modified elements are compared to each other and elements that were
+// only inserted or deleted are reported on their own.
+func (c *comparison) slices(actual, expected reflect.Value, what string) bool {
+	c.b.tb.Helper()
+	if actual.Len() == expected.Len() {
+		ok := true
+		for i := 0; i < actual.Len() && ok; i++ {
+			ok = c.quietly().equals(actual.Index(i), expected.Index(i), fmt.Sprintf("%s[%d]", what, i))
+		}
+		if ok {
+			return true
+		}
+	}
+	if c.quiet {
+		return false
+	}
+	edits, diffed := diff(actual.Len(), expected.Len(), func(i, j int) bool {
+		return c.quietly().equals(actual.Index(i), expected.Index(j), fmt.Sprintf("%s[%d]", what, i))
+	})
+	if !diffed {
+		for i := 0; i < min(actual.Len(), expected.Len()); i++ {
+			c.equals(actual.Index(i), expected.Index(i), fmt.Sprintf("%s[%d]", what, i))
+		}
+		if actual.Len() != expected.Len() {
+			c.errorNotEquals(actual.Len(), expected.Len(), fmt.Sprintf("len(%s)", what))
+		}
+		return false
+	}
+	ok := true
+	for _, h := range hunks(edits) {
+		modified := min(len(h.inserted), len(h.deleted))
+		for k := 0; k < modified; k++ {
+			i, j := h.inserted[k], h.deleted[k]
+			ok = c.equals(actual.Index(i), expected.Index(j), fmt.Sprintf("%s[%d]", what, i)) && ok
+		}
+		for _, j := range h.deleted[modified:] {
+			ok = c.errorElement("-", c.b.cfg.expectedTextStyle, fmt.Sprintf("%s[%d]", what, j), expected.Index(j)) && ok
+		}
+		for _, i := range h.inserted[modified:] {
+			ok = c.errorElement("+", c.b.cfg.actualTextStyle, fmt.Sprintf("%s[%d]", what, i), actual.Index(i)) && ok
+		}
+	}
+	return ok
+}
+
+// quietly returns a quiet copy of the comparison that shares its state.
+func (c *comparison) quietly() *comparison {
+	q := *c
+	q.quiet = true
+	return &q
+}
+
+// maps compares the values under the common keys, then reports the keys that
+// are only present in actual as unexpected and those only in expected as missing.
+func (c *comparison) maps(actual, expected reflect.Value, what string) bool {
+	c.b.tb.Helper()
+	ok := true
+	for _, k := range sortedKeys(actual) {
+		if !ok && c.quiet {
+			break
+		}
+		expectedValue := expected.MapIndex(k)
+		if !expectedValue.IsValid() {
+			if c.partial {
+				continue
+			}
+			ok = c.errorKey("unexpected", k, c.b.cfg.actualTextStyle, what) && ok
+			continue
+		}
+		ok = c.equals(actual.MapIndex(k), expectedValue, c.keyPath(what, k)) && ok
+	}
+	for _, k := range sortedKeys(expected) {
+		if !ok && c.quiet {
+			break
+		}
+		if !actual.MapIndex(k).IsValid() {
+			ok = c.errorKey("missing", k, c.b.cfg.expectedTextStyle, what) && ok
+		}
+	}
+	return ok
+}
+
+// keyPath returns the path of the value under key, with the key masked
+// below a field tagged with redact.
+func (c *comparison) keyPath(what string, key reflect.Value) string {
+	if c.redact {
+		return fmt.Sprintf("%s[%s]", what, redacted)
+	}
+	return fmt.Sprintf("%s[%v]", what, key)
+}
+
+// sortedKeys returns the keys of m in a stable order: numbers by their
+// value, strings and bools as themselves, anything else by its text.
+func sortedKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return compareKeys(keys[i], keys[j]) < 0
+	})
+	return keys
+}
+
+// compareKeys compares map keys like cmp.Compare when they have the same
+// kind, and by their text otherwise.
+func compareKeys(a, b reflect.Value) int {
+	if a.Kind() == reflect.Interface {
+		a = a.Elem()
+	}
+	if b.Kind() == reflect.Interface {
+		b = b.Elem()
+	}
+	if a.IsValid() && b.IsValid() && a.Kind() == b.Kind() {
+		switch a.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return cmp.Compare(a.Int(), b.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return cmp.Compare(a.Uint(), b.Uint())
+		case reflect.Float32, reflect.Float64:
+			return cmp.Compare(a.Float(), b.Float())
+		case reflect.String:
+			return cmp.Compare(a.String(), b.String())
+		case reflect.Bool:
+			switch {
+			case a.Bool() == b.Bool():
+				return 0
+			case !a.Bool():
+				return -1
+			}
+			return 1
+		}
+	}
+	return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+// cycleTo describes v as a back reference to where it was first seen,
+// or returns v itself when it does not close a cycle.
+func cycleTo(v reflect.Value, seen string, cycle bool) any {
+	if !cycle {
+		return v
+	}
+	if seen == "" {
+		return "<cycle to root>"
+	}
+	return fmt.Sprintf("<cycle to %s>", seen)
+}
+
+// chans compares channels by identity, unless draining is enabled and both
+// channels are buffered, in which case their pending elements are compared.
+// Drained elements are sent back to channels that are still open and writable.
+func (c *comparison) chans(actual, expected reflect.Value, what string) bool {
+	c.b.tb.Helper()
+	if actual.Pointer() == expected.Pointer() {
+		return true
+	}
+	// Only channels that can be received from and refilled are drained,
+	// except for closed channels, which are consumed.
+	drainable := c.b.cfg.drainChannels &&
+		actual.Type().ChanDir() == reflect.BothDir &&
+		actual.Cap() > 0 && expected.Cap() > 0 &&
+		actual.CanInterface() && expected.CanInterface()
+	if !drainable {
+		return c.errorNotEquals(actual, expected, what)
+	}
+	actualValues, actualClosed := c.drain(actual)
+	expectedValues, expectedClosed := c.drain(expected)
+	ok := true
+	for i := 0; i < min(len(actualValues), len(expectedValues)) && (ok || !c.quiet); i++ {
+		ok = c.equals(actualValues[i], expectedValues[i], fmt.Sprintf("<-%s[%d]", what, i)) && ok
+	}
+	if len(actualValues) != len(expectedValues) {
+		ok = c.errorNotEquals(len(actualValues), len(expectedValues), fmt.Sprintf("len(<-%s)", what))
+	}
+	if actualClosed != expectedClosed {
+		ok = c.errorNotEquals(actualClosed, expectedClosed, fmt.Sprintf("closed(%s)", what))
+	}
+	return ok
+}
+
+type drained struct {
+	values []reflect.Value
+	closed bool
+}
+
+// drain returns the pending elements of ch and whether it is closed. Closed
+// channels cannot be refilled, so the result is reused for later comparisons.
+func (c *comparison) drain(ch reflect.Value) ([]reflect.Value, bool) {
+	if d, ok := c.drained[ch.Pointer()]; ok {
+		return d.values, d.closed
+	}
+	values, closed := drain(ch)
+	c.drained[ch.Pointer()] = drained{values: values, closed: closed}
+	return values, closed
+}
+
+func drain(ch reflect.Value) ([]reflect.Value, bool) {
+	var values []reflect.Value
+	for {
+		v, ok := ch.TryRecv()
+		if !ok {
+			closed := v.IsValid()
+			if !closed && ch.Type().ChanDir()&reflect.SendDir != 0 {
+				for _, v := range values {
+					ch.TrySend(v)
+				}
+			}
+			return values, closed
+		}
+		values = append(values, v)
+	}
+}
+
+// funcs compares functions by nil-ness and by name. Closures and method values
+// with the same name share their code, so they cannot be told apart and are
+// reported instead of being assumed equal.
+func (c *comparison) funcs(actual, expected reflect.Value, what string) bool {
+	c.b.tb.Helper()
+	actualName := funcName(actual)
+	expectedName := funcName(expected)
+	if actualName != expectedName {
+		return c.errorNotEquals(actualName, expectedName, what)
+	}
+	if !actual.IsNil() && isClosure(actual) {
+		return c.error(actualName, expectedName, what, "is indistinguishable from")
+	}
+	return true
+}
+
+// closureName matches the names of closures, which follow the name of their
+// enclosing function, and of method values. The package path is trimmed
+// first, so that a package-level function named like funcN does not match.
+var closureName = regexp.MustCompile(`^[^.]+\.[^.].*\.func\d+(\.\d+)*$|-fm$`)
+
+func funcName(v reflect.Value) string {
+	if v.IsNil() {
+		return "<nil>"
+	}
+	f := runtime.FuncForPC(v.Pointer())
+	if f == nil {
+		return fmt.Sprintf("%#x", v.Pointer())
+	}
+	name := strings.TrimSuffix(f.Name(), "-fm")
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+func isClosure(v reflect.Value) bool {
+	f := runtime.FuncForPC(v.Pointer())
+	if f == nil {
+		return false
+	}
+	name := f.Name()
+	return closureName.MatchString(name[strings.LastIndex(name, "/")+1:])
+}
+
+// errorElement reports an element that is only present in one of the
+// slices, unless it is ignored.
+func (c *comparison) errorElement(sign string, style lipgloss.Style, what string, value reflect.Value) bool {
+	c.b.tb.Helper()
+	if c.b.cfg.ignoredPath(what) || c.b.cfg.ignoredType(value.Type()) {
+		return true
+	}
+	if c.redact {
+		c.b.errorElement(sign, style, what, redacted)
+		return false
+	}
+	c.b.errorElement(sign, style, what, value)
+	return false
+}
+
+// errorNotEquals reports the difference unless the comparison is quiet.
+// It always returns false so that callers can return its result directly.
+func (c *comparison) errorNotEquals(actual, expected any, what string) bool {
+	c.b.tb.Helper()
+	return c.error(actual, expected, what, "!=")
+}
+
+func (c *comparison) error(actual, expected any, what, relation string) bool {
+	c.b.tb.Helper()
+	if c.redact {
+		actual, expected = redacted, redacted
+	}
+	if !c.quiet {
+		c.b.error(actual, expected, what, relation)
+	}
+	return false
+}
+
+func (c *comparison) errorKey(problem string, key reflect.Value, style lipgloss.Style, what string) bool {
+	c.b.tb.Helper()
+	if c.b.cfg.ignoredPath(fmt.Sprintf("%s[%v]", what, key)) {
+		return true
+	}
+	if !c.quiet {
+		sKey := c.b.formatKey(key)
+		if c.redact {
+			sKey = redacted
+		}
+		c.b.errorf(what, "%s key %s", problem, style.Render(sKey))
+	}
+	return false
 }
 
 func (b *Bee) errorEquals(actual, expected any, what string) {
@@ -127,29 +574,87 @@ func (b *Bee) errorNotEquals(actual, expected any, what string) {
 
 func (b *Bee) error(actual, expected any, what, relation string) {
 	b.tb.Helper()
-	sActual := fmt.Sprintf("%v", actual)
-	sExpected := fmt.Sprintf("%v", expected)
-	format := "%s %s %s"
-	args := []any{
-		b.cfg.actualTextStyle.Render(wrap(b.tb, sActual, b.cfg.actualTextStyle.GetMaxWidth())),
-		relation,
-		b.cfg.expectedTextStyle.Render(wrap(b.tb, sExpected, b.cfg.expectedTextStyle.GetMaxWidth())),
+	sActual, sExpected := b.line(actual, expected, what, relation, true)
+	if strings.Contains(sActual, "\n") || strings.Contains(sExpected, "\n") {
+		if lines, ok := b.unifiedDiff(sActual, sExpected); ok {
+			b.tb.Logf("\n%s", lines)
+		} else {
+			b.logColumns(b.format(actual, true), b.format(expected, true))
+		}
+		return
+	}
+	if uniseg.StringWidth(sActual)+uniseg.StringWidth(sExpected) > b.cfg.expectedColumnStyle.GetWidth()+b.cfg.actualColumnStyle.GetWidth() {
+		b.logColumns(b.format(actual, true), b.format(expected, true))
+	}
+}
+
+// logColumns logs actual and expected side-by-side in two columns.
+func (b *Bee) logColumns(actual, expected string) {
+	b.tb.Helper()
+	b.tb.Logf(
+		"\n%s",
+		lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			b.cfg.actualColumnStyle.Render(actual),
+			b.cfg.expectedColumnStyle.Render(expected),
+		),
+	)
+}
+
+// errorLine reports actual and expected on a single line, truncated to the
+// column width, and returns their full formatted values.
+func (b *Bee) errorLine(actual, expected any, what, relation string) (string, string) {
+	b.tb.Helper()
+	return b.line(actual, expected, what, relation, false)
+}
+
+// line is errorLine that highlights the differing characters if highlight is
+// set and both values are single-line strings.
+func (b *Bee) line(actual, expected any, what, relation string, highlight bool) (string, string) {
+	b.tb.Helper()
+	sActual := b.format(actual, false)
+	sExpected := b.format(expected, false)
+	renderedActual := b.cfg.actualTextStyle.Render(wrap(b.tb, sActual, b.cfg.actualTextStyle.GetMaxWidth()))
+	renderedExpected := b.cfg.expectedTextStyle.Render(wrap(b.tb, sExpected, b.cfg.expectedTextStyle.GetMaxWidth()))
+	if highlight && isSingleLineString(actual) && isSingleLineString(expected) && sActual != sExpected {
+		if highlightedActual, highlightedExpected, ok := b.highlight(sActual, sExpected); ok {
+			renderedActual, renderedExpected = highlightedActual, highlightedExpected
+		}
 	}
+	b.errorf(what, "%s %s %s", renderedActual, relation, renderedExpected)
+	return sActual, sExpected
+}
+
+// errorf reports a failure, followed by the location of the difference, the
+// message of the assertion and the context of b, if any.
+func (b *Bee) errorf(what, format string, args ...any) {
+	b.tb.Helper()
 	if what != "" {
 		format += " (%s)"
 		args = append(args, b.cfg.whatTextStyle.Render(what))
 	}
-	b.tb.Errorf(format, args...)
-	if (len(sActual) + len(sExpected)) > (b.cfg.expectedColumnStyle.GetWidth() + b.cfg.actualColumnStyle.GetWidth()) {
-		b.tb.Logf(
-			"\n%s",
-			lipgloss.JoinHorizontal(
-				lipgloss.Top,
-				b.cfg.actualColumnStyle.Render(sActual),
-				b.cfg.expectedColumnStyle.Render(sExpected),
-			),
-		)
+	if b.message != "" {
+		format += ": %s"
+		args = append(args, b.cfg.messageTextStyle.Render(b.message))
 	}
+	if len(b.context) > 0 {
+		format += " [%s]"
+		args = append(args, b.cfg.messageTextStyle.Render(strings.Join(b.context, ", ")))
+	}
+	b.tb.Errorf(format, args...)
+}
+
+// errorElement reports an element that is only present in one of the values.
+func (b *Bee) errorElement(sign string, style lipgloss.Style, what string, value any) {
+	b.tb.Helper()
+	sValue := b.format(value, false)
+	b.errorf(
+		"",
+		"%s %s %s",
+		style.Render(sign),
+		b.cfg.whatTextStyle.Render(what),
+		style.Render(wrap(b.tb, sValue, style.GetMaxWidth())),
+	)
 }
 
 func isNil(tb testing.TB, value any) bool {
@@ -165,11 +670,17 @@ func isNil(tb testing.TB, value any) bool {
 	return false
 }
 
+func isSingleLineString(value any) bool {
+	s, ok := value.(string)
+	return ok && !strings.Contains(s, "\n")
+}
+
 func wrap(tb testing.TB, s string, w int) string {
 	tb.Helper()
 	s = strings.ReplaceAll(s, "\n", "")
-	if len(s) > w {
-		s = fmt.Sprintf("%s...", s[:w-3])
+	if uniseg.StringWidth(s) > w {
+		clusters := graphemes(s)
+		s = fmt.Sprintf("%s...", join(clusters[:fit(clusters, 0, w-3)]))
 	}
 	return s
 }
@@ -3,23 +3,35 @@ package bee_test
 import (
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
+	"slices"
+	"strings"
 	"testing"
+	"time"
+	"unicode/utf8"
 
 	"github.com/danielrenes/bee"
 )
 
 type mockT struct {
 	*testing.T
-	wasErr bool
-	wasLog bool
-	errMsg string
-	logMsg string
+	wasErr  bool
+	wasLog  bool
+	wasFail bool
+	errMsg  string
+	logMsg  string
+	errMsgs []string
 }
 
 func (mt *mockT) Errorf(format string, args ...any) {
 	mt.wasErr = true
 	mt.errMsg = fmt.Sprintf(format, args...)
+	mt.errMsgs = append(mt.errMsgs, mt.errMsg)
+}
+
+func (mt *mockT) FailNow() {
+	mt.wasFail = true
 }
 
 func (mt *mockT) Logf(format string, args ...any) {
@@ -113,7 +125,7 @@ func TestNil(t *testing.T) {
 		{
 			actual:     func(v int) *int { return &v }(1),
 			wantErr:    true,
-			errMsg:     "0x[0-9a-f]+ != <nil>",
+			errMsg:     "&1 != <nil>",
 			newMatcher: regex,
 			newBee:     newBeeWithoutColor(),
 		},
@@ -127,14 +139,14 @@ func TestNil(t *testing.T) {
 		{
 			actual:     []int{},
 			wantErr:    true,
-			errMsg:     "[] != <nil>",
+			errMsg:     "[]int{} != <nil>",
 			newMatcher: exact,
 			newBee:     newBeeWithoutColor(),
 		},
 		{
 			actual:     map[string]int{},
 			wantErr:    true,
-			errMsg:     "map[] != <nil>",
+			errMsg:     "map[string]int{} != <nil>",
 			newMatcher: exact,
 			newBee:     newBeeWithoutColor(),
 		},
@@ -176,7 +188,7 @@ func TestNil(t *testing.T) {
 		{
 			actual:     func(v int) *int { return &v }(1),
 			wantErr:    true,
-			errMsg:     "\x1b\\[38;2;250;40;25m0x[0-9a-f]+\x1b\\[0m != \x1b\\[38;2;18;181;32m<nil>\x1b\\[0m",
+			errMsg:     "\x1b\\[38;2;250;40;25m&1\x1b\\[0m != \x1b\\[38;2;18;181;32m<nil>\x1b\\[0m",
 			newMatcher: regex,
 			newBee:     newBeeWithDefaultColor(),
 		},
@@ -190,14 +202,14 @@ func TestNil(t *testing.T) {
 		{
 			actual:     []int{},
 			wantErr:    true,
-			errMsg:     "\x1b[38;2;250;40;25m[]\x1b[0m != \x1b[38;2;18;181;32m<nil>\x1b[0m",
+			errMsg:     "\x1b[38;2;250;40;25m[]int{}\x1b[0m != \x1b[38;2;18;181;32m<nil>\x1b[0m",
 			newMatcher: exact,
 			newBee:     newBeeWithDefaultColor(),
 		},
 		{
 			actual:     map[string]int{},
 			wantErr:    true,
-			errMsg:     "\x1b[38;2;250;40;25mmap[]\x1b[0m != \x1b[38;2;18;181;32m<nil>\x1b[0m",
+			errMsg:     "\x1b[38;2;250;40;25mmap[string]int{}\x1b[0m != \x1b[38;2;18;181;32m<nil>\x1b[0m",
 			newMatcher: exact,
 			newBee:     newBeeWithDefaultColor(),
 		},
@@ -239,7 +251,7 @@ func TestNil(t *testing.T) {
 		{
 			actual:     func(v int) *int { return &v }(1),
 			wantErr:    true,
-			errMsg:     "\x1b\\[38;2;1;1;1m0x[0-9a-f]+\x1b\\[0m != \x1b\\[38;2;2;2;2m<nil>\x1b\\[0m",
+			errMsg:     "\x1b\\[38;2;1;1;1m&1\x1b\\[0m != \x1b\\[38;2;2;2;2m<nil>\x1b\\[0m",
 			newMatcher: regex,
 			newBee:     newBeeWithCustomColor(),
 		},
@@ -253,14 +265,14 @@ func TestNil(t *testing.T) {
 		{
 			actual:     []int{},
 			wantErr:    true,
-			errMsg:     "\x1b[38;2;1;1;1m[]\x1b[0m != \x1b[38;2;2;2;2m<nil>\x1b[0m",
+			errMsg:     "\x1b[38;2;1;1;1m[]int{}\x1b[0m != \x1b[38;2;2;2;2m<nil>\x1b[0m",
 			newMatcher: exact,
 			newBee:     newBeeWithCustomColor(),
 		},
 		{
 			actual:     map[string]int{},
 			wantErr:    true,
-			errMsg:     "\x1b[38;2;1;1;1mmap[]\x1b[0m != \x1b[38;2;2;2;2m<nil>\x1b[0m",
+			errMsg:     "\x1b[38;2;1;1;1mmap[string]int{}\x1b[0m != \x1b[38;2;2;2;2m<nil>\x1b[0m",
 			newMatcher: exact,
 			newBee:     newBeeWithCustomColor(),
 		},
@@ -323,7 +335,7 @@ func TestNotNil(t *testing.T) {
 		{
 			actual:  (*int)(nil),
 			wantErr: true,
-			errMsg:  "<nil> == <nil>",
+			errMsg:  "(*int)(nil) == <nil>",
 			newBee:  newBeeWithoutColor(),
 		},
 		{
@@ -335,13 +347,13 @@ func TestNotNil(t *testing.T) {
 		{
 			actual:  ([]int)(nil),
 			wantErr: true,
-			errMsg:  "[] == <nil>",
+			errMsg:  "[]int(nil) == <nil>",
 			newBee:  newBeeWithoutColor(),
 		},
 		{
 			actual:  (map[string]int)(nil),
 			wantErr: true,
-			errMsg:  "map[] == <nil>",
+			errMsg:  "map[string]int(nil) == <nil>",
 			newBee:  newBeeWithoutColor(),
 		},
 		{
@@ -377,7 +389,7 @@ func TestNotNil(t *testing.T) {
 		{
 			actual:  (*int)(nil),
 			wantErr: true,
-			errMsg:  "\x1b[38;2;250;40;25m<nil>\x1b[0m == \x1b[38;2;18;181;32m<nil>\x1b[0m",
+			errMsg:  "\x1b[38;2;250;40;25m(*int)(nil)\x1b[0m == \x1b[38;2;18;181;32m<nil>\x1b[0m",
 			newBee:  newBeeWithDefaultColor(),
 		},
 		{
@@ -389,13 +401,13 @@ func TestNotNil(t *testing.T) {
 		{
 			actual:  ([]int)(nil),
 			wantErr: true,
-			errMsg:  "\x1b[38;2;250;40;25m[]\x1b[0m == \x1b[38;2;18;181;32m<nil>\x1b[0m",
+			errMsg:  "\x1b[38;2;250;40;25m[]int(nil)\x1b[0m == \x1b[38;2;18;181;32m<nil>\x1b[0m",
 			newBee:  newBeeWithDefaultColor(),
 		},
 		{
 			actual:  (map[string]int)(nil),
 			wantErr: true,
-			errMsg:  "\x1b[38;2;250;40;25mmap[]\x1b[0m == \x1b[38;2;18;181;32m<nil>\x1b[0m",
+			errMsg:  "\x1b[38;2;250;40;25mmap[string]int(nil)\x1b[0m == \x1b[38;2;18;181;32m<nil>\x1b[0m",
 			newBee:  newBeeWithDefaultColor(),
 		},
 		{
@@ -431,7 +443,7 @@ func TestNotNil(t *testing.T) {
 		{
 			actual:  (*int)(nil),
 			wantErr: true,
-			errMsg:  "\x1b[38;2;1;1;1m<nil>\x1b[0m == \x1b[38;2;2;2;2m<nil>\x1b[0m",
+			errMsg:  "\x1b[38;2;1;1;1m(*int)(nil)\x1b[0m == \x1b[38;2;2;2;2m<nil>\x1b[0m",
 			newBee:  newBeeWithCustomColor(),
 		},
 		{
@@ -443,13 +455,13 @@ func TestNotNil(t *testing.T) {
 		{
 			actual:  ([]int)(nil),
 			wantErr: true,
-			errMsg:  "\x1b[38;2;1;1;1m[]\x1b[0m == \x1b[38;2;2;2;2m<nil>\x1b[0m",
+			errMsg:  "\x1b[38;2;1;1;1m[]int(nil)\x1b[0m == \x1b[38;2;2;2;2m<nil>\x1b[0m",
 			newBee:  newBeeWithCustomColor(),
 		},
 		{
 			actual:  (map[string]int)(nil),
 			wantErr: true,
-			errMsg:  "\x1b[38;2;1;1;1mmap[]\x1b[0m == \x1b[38;2;2;2;2m<nil>\x1b[0m",
+			errMsg:  "\x1b[38;2;1;1;1mmap[string]int(nil)\x1b[0m == \x1b[38;2;2;2;2m<nil>\x1b[0m",
 			newBee:  newBeeWithCustomColor(),
 		},
 	}
@@ -908,7 +920,7 @@ func TestEqual(t *testing.T) {
 			actual:   []int{0},
 			expected: []int{0, 0},
 			wantErr:  true,
-			errMsg:   "1 != 2 (len())",
+			errMsg:   "- [1] 0",
 			newBee:   newBeeWithoutColor(),
 		},
 		{
@@ -929,7 +941,7 @@ func TestEqual(t *testing.T) {
 			actual:   map[string]int{"a": 1},
 			expected: map[string]int{"a": 1, "b": 2},
 			wantErr:  true,
-			errMsg:   "1 != 2 (len())",
+			errMsg:   "missing key \"b\"",
 			newBee:   newBeeWithoutColor(),
 		},
 		{
@@ -957,7 +969,7 @@ func TestEqual(t *testing.T) {
 			actual:   testStruct{b: []int{0}},
 			expected: testStruct{b: []int{0, 0}},
 			wantErr:  true,
-			errMsg:   "1 != 2 (len(.b))",
+			errMsg:   "- .b[1] 0",
 			newBee:   newBeeWithoutColor(),
 		},
 		{
@@ -971,7 +983,7 @@ func TestEqual(t *testing.T) {
 			actual:   testStruct{c: map[string]int{"a": 1}},
 			expected: testStruct{c: map[string]int{"a": 1, "b": 2}},
 			wantErr:  true,
-			errMsg:   "1 != 2 (len(.c))",
+			errMsg:   "missing key \"b\" (.c)",
 			newBee:   newBeeWithoutColor(),
 		},
 		{
@@ -1285,7 +1297,7 @@ func TestEqual(t *testing.T) {
 			actual:   []int{0},
 			expected: []int{0, 0},
 			wantErr:  true,
-			errMsg:   "\x1b[38;2;250;40;25m1\x1b[0m != \x1b[38;2;18;181;32m2\x1b[0m (\x1b[38;2;2;118;250mlen()\x1b[0m)",
+			errMsg:   "\x1b[38;2;18;181;32m-\x1b[0m \x1b[38;2;2;118;250m[1]\x1b[0m \x1b[38;2;18;181;32m0\x1b[0m",
 			newBee:   newBeeWithDefaultColor(),
 		},
 		{
@@ -1306,7 +1318,7 @@ func TestEqual(t *testing.T) {
 			actual:   map[string]int{"a": 1},
 			expected: map[string]int{"a": 1, "b": 2},
 			wantErr:  true,
-			errMsg:   "\x1b[38;2;250;40;25m1\x1b[0m != \x1b[38;2;18;181;32m2\x1b[0m (\x1b[38;2;2;118;250mlen()\x1b[0m)",
+			errMsg:   "missing key \x1b[38;2;18;181;32m\"b\"\x1b[0m",
 			newBee:   newBeeWithDefaultColor(),
 		},
 		{
@@ -1334,7 +1346,7 @@ func TestEqual(t *testing.T) {
 			actual:   testStruct{b: []int{0}},
 			expected: testStruct{b: []int{0, 0}},
 			wantErr:  true,
-			errMsg:   "\x1b[38;2;250;40;25m1\x1b[0m != \x1b[38;2;18;181;32m2\x1b[0m (\x1b[38;2;2;118;250mlen(.b)\x1b[0m)",
+			errMsg:   "\x1b[38;2;18;181;32m-\x1b[0m \x1b[38;2;2;118;250m.b[1]\x1b[0m \x1b[38;2;18;181;32m0\x1b[0m",
 			newBee:   newBeeWithDefaultColor(),
 		},
 		{
@@ -1348,7 +1360,7 @@ func TestEqual(t *testing.T) {
 			actual:   testStruct{c: map[string]int{"a": 1}},
 			expected: testStruct{c: map[string]int{"a": 1, "b": 2}},
 			wantErr:  true,
-			errMsg:   "\x1b[38;2;250;40;25m1\x1b[0m != \x1b[38;2;18;181;32m2\x1b[0m (\x1b[38;2;2;118;250mlen(.c)\x1b[0m)",
+			errMsg:   "missing key \x1b[38;2;18;181;32m\"b\"\x1b[0m (\x1b[38;2;2;118;250m.c\x1b[0m)",
 			newBee:   newBeeWithDefaultColor(),
 		},
 		{
@@ -1656,7 +1668,7 @@ func TestEqual(t *testing.T) {
 			actual:   []int{0},
 			expected: []int{0, 0},
 			wantErr:  true,
-			errMsg:   "\x1b[38;2;1;1;1m1\x1b[0m != \x1b[38;2;2;2;2m2\x1b[0m (\x1b[38;2;3;3;3mlen()\x1b[0m)",
+			errMsg:   "\x1b[38;2;2;2;2m-\x1b[0m \x1b[38;2;3;3;3m[1]\x1b[0m \x1b[38;2;2;2;2m0\x1b[0m",
 			newBee:   newBeeWithCustomColor(),
 		},
 		{
@@ -1677,7 +1689,7 @@ func TestEqual(t *testing.T) {
 			actual:   map[string]int{"a": 1},
 			expected: map[string]int{"a": 1, "b": 2},
 			wantErr:  true,
-			errMsg:   "\x1b[38;2;1;1;1m1\x1b[0m != \x1b[38;2;2;2;2m2\x1b[0m (\x1b[38;2;3;3;3mlen()\x1b[0m)",
+			errMsg:   "missing key \x1b[38;2;2;2;2m\"b\"\x1b[0m",
 			newBee:   newBeeWithCustomColor(),
 		},
 		{
@@ -1705,7 +1717,7 @@ func TestEqual(t *testing.T) {
 			actual:   testStruct{b: []int{0}},
 			expected: testStruct{b: []int{0, 0}},
 			wantErr:  true,
-			errMsg:   "\x1b[38;2;1;1;1m1\x1b[0m != \x1b[38;2;2;2;2m2\x1b[0m (\x1b[38;2;3;3;3mlen(.b)\x1b[0m)",
+			errMsg:   "\x1b[38;2;2;2;2m-\x1b[0m \x1b[38;2;3;3;3m.b[1]\x1b[0m \x1b[38;2;2;2;2m0\x1b[0m",
 			newBee:   newBeeWithCustomColor(),
 		},
 		{
@@ -1719,7 +1731,7 @@ func TestEqual(t *testing.T) {
 			actual:   testStruct{c: map[string]int{"a": 1}},
 			expected: testStruct{c: map[string]int{"a": 1, "b": 2}},
 			wantErr:  true,
-			errMsg:   "\x1b[38;2;1;1;1m1\x1b[0m != \x1b[38;2;2;2;2m2\x1b[0m (\x1b[38;2;3;3;3mlen(.c)\x1b[0m)",
+			errMsg:   "missing key \x1b[38;2;2;2;2m\"b\"\x1b[0m (\x1b[38;2;3;3;3m.c\x1b[0m)",
 			newBee:   newBeeWithCustomColor(),
 		},
 		{
@@ -1748,10 +1760,1677 @@ func TestEqual(t *testing.T) {
 	}
 }
 
+func TestNotEqual(t *testing.T) {
+	type testStruct struct {
+		a int
+		b []int
+		c map[string]int
+	}
+
+	tests := []struct {
+		actual   any
+		expected any
+		wantErr  bool
+		errMsg   string
+		newBee   newBee
+	}{
+		{
+			actual:   true,
+			expected: 1,
+			wantErr:  false,
+			errMsg:   "",
+			newBee:   newBeeWithoutColor(),
+		},
+		{
+			actual:   1,
+			expected: 2,
+			wantErr:  false,
+			errMsg:   "",
+			newBee:   newBeeWithoutColor(),
+		},
+		{
+			actual:   1,
+			expected: 1,
+			wantErr:  true,
+			errMsg:   "1 == 1",
+			newBee:   newBeeWithoutColor(),
+		},
+		{
+			actual:   nil,
+			expected: nil,
+			wantErr:  true,
+			errMsg:   "<nil> == <nil>",
+			newBee:   newBeeWithoutColor(),
+		},
+		{
+			actual:   []int{1, 2},
+			expected: []int{1, 3},
+			wantErr:  false,
+			errMsg:   "",
+			newBee:   newBeeWithoutColor(),
+		},
+		{
+			actual:   testStruct{a: 1, b: []int{2}, c: map[string]int{"a": 3}},
+			expected: testStruct{a: 1, b: []int{2}, c: map[string]int{"a": 4}},
+			wantErr:  false,
+			errMsg:   "",
+			newBee:   newBeeWithoutColor(),
+		},
+		{
+			actual:   testStruct{a: 1, b: []int{2}, c: map[string]int{"a": 3}},
+			expected: testStruct{a: 1, b: []int{2}, c: map[string]int{"a": 3}},
+			wantErr:  true,
+			errMsg:   "bee_test.testStruct{a: 1, b: []int{2}, c: map[string]int{... == bee_test.testStruct{a: 1, b: []int{2}, c: map[string]int{...",
+			newBee:   newBeeWithoutColor(),
+		},
+		{
+			actual:   "a",
+			expected: "a",
+			wantErr:  true,
+			errMsg:   "\x1b[38;2;250;40;25ma\x1b[0m == \x1b[38;2;18;181;32ma\x1b[0m",
+			newBee:   newBeeWithDefaultColor(),
+		},
+		{
+			actual:   "a",
+			expected: "a",
+			wantErr:  true,
+			errMsg:   "\x1b[38;2;1;1;1ma\x1b[0m == \x1b[38;2;2;2;2ma\x1b[0m",
+			newBee:   newBeeWithCustomColor(),
+		},
+	}
+
+	for _, test := range tests {
+		mockT := &mockT{T: t}
+		bee := test.newBee(mockT)
+		bee.NotEqual(test.actual, test.expected)
+		if mockT.wasErr != test.wantErr {
+			if test.wantErr {
+				t.Error("expected error")
+			} else {
+				t.Error("expected no error")
+			}
+		}
+		if mockT.errMsg != test.errMsg {
+			t.Errorf("%q != %q", mockT.errMsg, test.errMsg)
+		}
+	}
+}
+
+func TestEqualChannels(t *testing.T) {
+	buffered := func(capacity int, closed bool, values ...int) chan int {
+		ch := make(chan int, capacity)
+		for _, v := range values {
+			ch <- v
+		}
+		if closed {
+			close(ch)
+		}
+		return ch
+	}
+	same := make(chan int)
+	closed := buffered(1, true, 1)
+
+	tests := []struct {
+		actual   any
+		expected any
+		wantErr  bool
+		errMsg   string
+		newBee   newBee
+	}{
+		{
+			actual:   same,
+			expected: same,
+			wantErr:  false,
+			errMsg:   "",
+			newBee:   newBeeWithoutColor(),
+		},
+		{
+			actual:   buffered(2, false, 1),
+			expected: buffered(2, false, 1),
+			wantErr:  true,
+			errMsg:   "",
+			newBee:   newBeeWithoutColor(),
+		},
+		{
+			actual:   make(chan int),
+			expected: make(chan int),
+			wantErr:  true,
+			errMsg:   "",
+			newBee:   func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.DrainChannels()) },
+		},
+		{
+			actual:   buffered(3, false, 1, 2),
+			expected: buffered(3, false, 1, 2),
+			wantErr:  false,
+			errMsg:   "",
+			newBee:   func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.DrainChannels()) },
+		},
+		{
+			actual:   buffered(3, false, 1, 2, 3),
+			expected: buffered(3, false, 1, 2, 4),
+			wantErr:  true,
+			errMsg:   "3 != 4 (<-[2])",
+			newBee:   func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.DrainChannels()) },
+		},
+		{
+			actual:   buffered(3, false, 1),
+			expected: buffered(3, false, 1, 2),
+			wantErr:  true,
+			errMsg:   "1 != 2 (len(<-))",
+			newBee:   func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.DrainChannels()) },
+		},
+		{
+			actual:   buffered(3, true, 1),
+			expected: buffered(3, false, 1),
+			wantErr:  true,
+			errMsg:   "true != false (closed())",
+			newBee:   func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.DrainChannels()) },
+		},
+		{
+			actual:   struct{ C chan int }{C: buffered(1, false, 1)},
+			expected: struct{ C chan int }{C: buffered(1, false, 2)},
+			wantErr:  true,
+			errMsg:   "1 != 2 (<-.C[0])",
+			newBee:   func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.DrainChannels()) },
+		},
+		{
+			actual:   []chan int{buffered(2, true, 1)},
+			expected: []chan int{buffered(2, true, 1, 2)},
+			wantErr:  true,
+			errMsg:   "1 != 2 (len(<-[0]))",
+			newBee:   func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.DrainChannels()) },
+		},
+		{
+			actual:   struct{ Out chan<- int }{Out: buffered(1, false, 1)},
+			expected: struct{ Out chan<- int }{Out: buffered(1, false, 1)},
+			wantErr:  true,
+			errMsg:   "",
+			newBee:   func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.DrainChannels()) },
+		},
+		{
+			actual:   struct{ In <-chan int }{In: buffered(1, false, 1)},
+			expected: struct{ In <-chan int }{In: buffered(1, false, 1)},
+			wantErr:  true,
+			errMsg:   "",
+			newBee:   func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.DrainChannels()) },
+		},
+		{
+			actual:   struct{ In <-chan int }{In: same},
+			expected: struct{ In <-chan int }{In: same},
+			wantErr:  false,
+			errMsg:   "",
+			newBee:   func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.DrainChannels()) },
+		},
+		{
+			actual:   []chan int{closed, closed},
+			expected: []chan int{buffered(1, true, 1), buffered(1, true, 1)},
+			wantErr:  false,
+			errMsg:   "",
+			newBee:   func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.DrainChannels()) },
+		},
+	}
+
+	for _, test := range tests {
+		mockT := &mockT{T: t}
+		bee := test.newBee(mockT)
+		bee.Equal(test.actual, test.expected)
+		if mockT.wasErr != test.wantErr {
+			if test.wantErr {
+				t.Error("expected error")
+			} else {
+				t.Error("expected no error")
+			}
+		}
+		if test.errMsg != "" && mockT.errMsg != test.errMsg {
+			t.Errorf("%q != %q", mockT.errMsg, test.errMsg)
+		}
+	}
+
+	ch := buffered(2, false, 1, 2)
+	bee.New(&mockT{T: t}, bee.DrainChannels()).Equal(ch, buffered(2, false, 1, 2))
+	if len(ch) != 2 {
+		t.Errorf("%d != 2", len(ch))
+	}
+
+	type source struct{ In <-chan int }
+	in := buffered(2, false, 1, 2)
+	bee.New(&mockT{T: t}, bee.DrainChannels()).Equal(source{In: in}, source{In: buffered(2, false, 1, 2)})
+	if len(in) != 2 {
+		t.Errorf("%d != 2", len(in))
+	}
+}
+
+type handler struct{}
+
+func (*handler) Save() {}
+
+func (*handler) Close() {}
+
+func save(*handler) {}
+
+func func1(*handler) {}
+
+func TestEqualFuncs(t *testing.T) {
+	type hooks struct {
+		OnSave  func(*handler)
+		OnClose func()
+	}
+	closure := func() func(*handler) {
+		return func(*handler) {}
+	}
+
+	tests := []struct {
+		actual   any
+		expected any
+		wantErr  bool
+		errMsg   string
+	}{
+		{
+			actual:   hooks{},
+			expected: hooks{},
+			wantErr:  false,
+			errMsg:   "",
+		},
+		{
+			actual:   hooks{OnSave: save},
+			expected: hooks{OnSave: save},
+			wantErr:  false,
+			errMsg:   "",
+		},
+		{
+			actual:   hooks{OnSave: save},
+			expected: hooks{},
+			wantErr:  true,
+			errMsg:   "bee_test.save != <nil> (.OnSave)",
+		},
+		{
+			actual:   hooks{OnSave: (*handler).Save},
+			expected: hooks{OnSave: save},
+			wantErr:  true,
+			errMsg:   "bee_test.(*handler).Save != bee_test.save (.OnSave)",
+		},
+		{
+			actual:   hooks{OnClose: (&handler{}).Save},
+			expected: hooks{OnClose: (&handler{}).Close},
+			wantErr:  true,
+			errMsg:   "bee_test.(*handler).Save != bee_test.(*handler).Close (.OnClose)",
+		},
+		{
+			actual:   hooks{OnClose: (&handler{}).Close},
+			expected: hooks{OnClose: (&handler{}).Close},
+			wantErr:  true,
+			errMsg:   "bee_test.(*handler).Close is indistinguishable from bee_test.(*handler).Close (.OnClose)",
+		},
+		{
+			actual:   hooks{OnSave: func1},
+			expected: hooks{OnSave: func1},
+			wantErr:  false,
+			errMsg:   "",
+		},
+		{
+			actual:   hooks{OnSave: closure()},
+			expected: hooks{OnSave: closure()},
+			wantErr:  true,
+			errMsg:   "bee_test.TestEqualFuncs.func1.func1 is indistinguishable from bee_test.TestEqualFuncs.func1.func1 (.OnSave)",
+		},
+	}
+
+	for _, test := range tests {
+		mockT := &mockT{T: t}
+		bee := bee.New(mockT, bee.NoColor())
+		bee.Equal(test.actual, test.expected)
+		if mockT.wasErr != test.wantErr {
+			if test.wantErr {
+				t.Error("expected error")
+			} else {
+				t.Error("expected no error")
+			}
+		}
+		if mockT.errMsg != test.errMsg {
+			t.Errorf("%q != %q", mockT.errMsg, test.errMsg)
+		}
+	}
+}
+
+func TestEqualSlices(t *testing.T) {
+	type item struct {
+		ID   int
+		Name string
+	}
+	items := func(n int) []item {
+		s := make([]item, n)
+		for i := range s {
+			s[i] = item{ID: i, Name: fmt.Sprintf("item-%d", i)}
+		}
+		return s
+	}
+
+	tests := []struct {
+		actual   any
+		expected any
+		errMsgs  []string
+	}{
+		{
+			actual:   []int{0, 1, 2, 3},
+			expected: []int{1, 2, 3},
+			errMsgs:  []string{"+ [0] 0"},
+		},
+		{
+			actual:   []int{1, 2, 3},
+			expected: []int{1, 2, 3, 4},
+			errMsgs:  []string{"- [3] 4"},
+		},
+		{
+			actual:   []int{1, 5, 3},
+			expected: []int{1, 2, 3},
+			errMsgs:  []string{"5 != 2 ([1])"},
+		},
+		{
+			actual:   [4]int{9, 1, 2, 3},
+			expected: [4]int{1, 2, 3, 4},
+			errMsgs:  []string{"+ [0] 9", "- [3] 4"},
+		},
+		{
+			actual:   append([]item{{ID: -1, Name: "new"}}, items(500)...),
+			expected: items(500),
+			errMsgs:  []string{`+ [0] bee_test.item{ID: -1, Name: "new"}`},
+		},
+		{
+			actual:   append(items(3)[:1], item{ID: 1, Name: "renamed"}, item{ID: 2, Name: "item-2"}),
+			expected: items(3),
+			errMsgs:  []string{"renamed != item-1 ([1].Name)"},
+		},
+		{
+			actual:   struct{ S []string }{S: []string{"a", "x", "c"}},
+			expected: struct{ S []string }{S: []string{"a", "b", "c", "d"}},
+			errMsgs:  []string{"x != b (.S[1])", "- .S[3] d"},
+		},
+	}
+
+	for _, test := range tests {
+		mockT := &mockT{T: t}
+		bee := bee.New(mockT, bee.NoColor())
+		bee.Equal(test.actual, test.expected)
+		if len(mockT.errMsgs) != len(test.errMsgs) {
+			t.Errorf("%q != %q", mockT.errMsgs, test.errMsgs)
+			continue
+		}
+		for i := range test.errMsgs {
+			if mockT.errMsgs[i] != test.errMsgs[i] {
+				t.Errorf("%q != %q", mockT.errMsgs[i], test.errMsgs[i])
+			}
+		}
+	}
+}
+
+func TestEqualMaps(t *testing.T) {
+	tests := []struct {
+		actual   any
+		expected any
+		errMsgs  []string
+	}{
+		{
+			actual:   map[string]int{"alice": 1, "eve": 2},
+			expected: map[string]int{"alice": 1, "bob": 2},
+			errMsgs:  []string{`unexpected key "eve"`, `missing key "bob"`},
+		},
+		{
+			actual:   map[string]int{"alice": 2, "eve": 2},
+			expected: map[string]int{"alice": 1},
+			errMsgs:  []string{"2 != 1 ([alice])", `unexpected key "eve"`},
+		},
+		{
+			actual:   map[int]string{1: "a"},
+			expected: map[int]string{1: "b", 2: "c"},
+			errMsgs:  []string{"a != b ([1])", "missing key 2"},
+		},
+		{
+			actual:   struct{ M map[string]int }{M: map[string]int{}},
+			expected: struct{ M map[string]int }{M: map[string]int{"bob": 1}},
+			errMsgs:  []string{`missing key "bob" (.M)`},
+		},
+	}
+
+	for _, test := range tests {
+		mockT := &mockT{T: t}
+		bee := bee.New(mockT, bee.NoColor())
+		bee.Equal(test.actual, test.expected)
+		if len(mockT.errMsgs) != len(test.errMsgs) {
+			t.Errorf("%q != %q", mockT.errMsgs, test.errMsgs)
+			continue
+		}
+		for i := range test.errMsgs {
+			if mockT.errMsgs[i] != test.errMsgs[i] {
+				t.Errorf("%q != %q", mockT.errMsgs[i], test.errMsgs[i])
+			}
+		}
+	}
+}
+
+func TestEqualFloats(t *testing.T) {
+	tests := []struct {
+		actual   any
+		expected any
+		wantErr  bool
+		errMsg   string
+		newBee   newBee
+	}{
+		{
+			actual:   1.0,
+			expected: 1.0 + 1e-10,
+			wantErr:  false,
+			errMsg:   "",
+			newBee:   newBeeWithoutColor(),
+		},
+		{
+			actual:   math.NaN(),
+			expected: 42.0,
+			wantErr:  true,
+			errMsg:   "NaN != 42",
+			newBee:   newBeeWithoutColor(),
+		},
+		{
+			actual:   42.0,
+			expected: math.NaN(),
+			wantErr:  true,
+			errMsg:   "42 != NaN",
+			newBee:   newBeeWithoutColor(),
+		},
+		{
+			actual:   math.NaN(),
+			expected: math.NaN(),
+			wantErr:  true,
+			errMsg:   "NaN != NaN",
+			newBee:   newBeeWithoutColor(),
+		},
+		{
+			actual:   math.NaN(),
+			expected: math.NaN(),
+			wantErr:  false,
+			errMsg:   "",
+			newBee:   func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.NaNEqual()) },
+		},
+		{
+			actual:   math.NaN(),
+			expected: 1.0,
+			wantErr:  true,
+			errMsg:   "NaN != 1",
+			newBee:   func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.NaNEqual()) },
+		},
+		{
+			actual:   math.Inf(1),
+			expected: math.Inf(1),
+			wantErr:  false,
+			errMsg:   "",
+			newBee:   newBeeWithoutColor(),
+		},
+		{
+			actual:   math.Inf(1),
+			expected: math.Inf(-1),
+			wantErr:  true,
+			errMsg:   "+Inf != -Inf",
+			newBee:   newBeeWithoutColor(),
+		},
+		{
+			actual:   math.Inf(1),
+			expected: math.MaxFloat64,
+			wantErr:  true,
+			errMsg:   "+Inf != 1.7976931348623157e+308",
+			newBee:   newBeeWithoutColor(),
+		},
+		{
+			actual:   []float32{float32(math.NaN())},
+			expected: []float32{1},
+			wantErr:  true,
+			errMsg:   "NaN != 1 ([0])",
+			newBee:   newBeeWithoutColor(),
+		},
+	}
+
+	for _, test := range tests {
+		mockT := &mockT{T: t}
+		bee := test.newBee(mockT)
+		bee.Equal(test.actual, test.expected)
+		if mockT.wasErr != test.wantErr {
+			if test.wantErr {
+				t.Error("expected error")
+			} else {
+				t.Error("expected no error")
+			}
+		}
+		if mockT.errMsg != test.errMsg {
+			t.Errorf("%q != %q", mockT.errMsg, test.errMsg)
+		}
+	}
+}
+
+func TestFloatTolerance(t *testing.T) {
+	tests := []struct {
+		actual   any
+		expected any
+		wantErr  bool
+		using    func(*bee.Bee) *bee.Bee
+	}{
+		{
+			actual:   1.0,
+			expected: 1.001,
+			wantErr:  true,
+		},
+		{
+			actual:   1.0,
+			expected: 1.001,
+			wantErr:  false,
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(bee.FloatAbsTolerance(1e-2)) },
+		},
+		{
+			actual:   1e-12,
+			expected: 2e-12,
+			wantErr:  false,
+		},
+		{
+			actual:   1e-12,
+			expected: 2e-12,
+			wantErr:  true,
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(bee.FloatRelTolerance(1e-3)) },
+		},
+		{
+			actual:   1e12,
+			expected: 1e12 + 1,
+			wantErr:  true,
+		},
+		{
+			actual:   1e12,
+			expected: 1e12 + 1,
+			wantErr:  false,
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(bee.FloatRelTolerance(1e-9)) },
+		},
+		{
+			actual:   1.0,
+			expected: math.Nextafter(1, 2),
+			wantErr:  true,
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(bee.FloatULP(0)) },
+		},
+		{
+			actual:   1.0,
+			expected: math.Nextafter(1, 2),
+			wantErr:  false,
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(bee.FloatULP(1)) },
+		},
+		{
+			actual:   1.0,
+			expected: math.Nextafter(math.Nextafter(1, 2), 2),
+			wantErr:  true,
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(bee.FloatULP(1)) },
+		},
+		{
+			actual:   float32(1),
+			expected: math.Nextafter32(1, 2),
+			wantErr:  false,
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(bee.FloatULP(1)) },
+		},
+		{
+			actual:   float32(-1e-45),
+			expected: float32(1e-45),
+			wantErr:  false,
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(bee.FloatULP(2)) },
+		},
+		{
+			actual:   complex(1, 1),
+			expected: complex(1, 1+1e-10),
+			wantErr:  false,
+		},
+		{
+			actual:   complex(1, 1),
+			expected: complex(1, 1.001),
+			wantErr:  true,
+		},
+		{
+			actual:   complex64(complex(1, 1)),
+			expected: complex(1, math.Nextafter32(1, 2)),
+			wantErr:  false,
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(bee.FloatULP(1)) },
+		},
+		{
+			actual:   complex(1, 1),
+			expected: complex(1.001, 1),
+			wantErr:  false,
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(bee.FloatAbsTolerance(1e-2)) },
+		},
+	}
+
+	for _, test := range tests {
+		mockT := &mockT{T: t}
+		b := bee.New(mockT, bee.NoColor())
+		if test.using != nil {
+			b = test.using(b)
+		}
+		b.Equal(test.actual, test.expected)
+		if mockT.wasErr != test.wantErr {
+			t.Errorf("%v, %v: %v != %v", test.actual, test.expected, mockT.wasErr, test.wantErr)
+		}
+	}
+}
+
+type record struct {
+	ID        int
+	CreatedAt time.Time
+	Meta      map[string]any
+	Items     []*record
+}
+
+func TestIgnore(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		actual   any
+		expected any
+		wantErr  bool
+		errMsgs  []string
+		using    func(*bee.Bee) *bee.Bee
+	}{
+		{
+			actual:   record{ID: 1, Meta: map[string]any{"v": 1}},
+			expected: record{ID: 2, Meta: map[string]any{"v": 2}},
+			wantErr:  true,
+			errMsgs:  []string{"1 != 2 (.ID)", "1 != 2 (.Meta[v])"},
+		},
+		{
+			actual:   record{ID: 1, Meta: map[string]any{"v": 1}},
+			expected: record{ID: 2, Meta: map[string]any{"v": 2}},
+			wantErr:  false,
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(bee.IgnorePaths(".ID", ".Meta")) },
+		},
+		{
+			actual:   &record{ID: 1, Meta: map[string]any{"v": 1, "w": 1}},
+			expected: &record{ID: 1, Meta: map[string]any{"v": 2}},
+			wantErr:  true,
+			errMsgs:  []string{`unexpected key "w" (*.Meta)`},
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(bee.IgnorePaths(".Meta[v]")) },
+		},
+		{
+			actual:   &record{Meta: map[string]any{"v": 1, "w": 1}},
+			expected: &record{Meta: map[string]any{"v": 2}},
+			wantErr:  false,
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(bee.IgnorePaths("Meta[*]")) },
+		},
+		{
+			actual:   record{Items: []*record{{ID: 1}, {ID: 2}}},
+			expected: record{Items: []*record{{ID: 3}, {ID: 4}}},
+			wantErr:  false,
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(bee.IgnorePaths(".Items[*].ID")) },
+		},
+		{
+			actual:   record{Items: []*record{{ID: 1}, {ID: 2, CreatedAt: now}}},
+			expected: record{Items: []*record{{ID: 3}, {ID: 4}}},
+			wantErr:  true,
+			errMsgs:  []string{"1 != 3 (*.Items[0].ID)", "2 != 4 (*.Items[1].ID)"},
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(bee.IgnoreType[time.Time]()) },
+		},
+		{
+			actual:   []record{{ID: 1, CreatedAt: now}, {ID: 2}},
+			expected: []record{{ID: 1}},
+			wantErr:  false,
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(bee.IgnoreType[time.Time](), bee.IgnorePaths("[1]")) },
+		},
+		{
+			actual:   []record{{ID: 1, CreatedAt: now}, {ID: 2}},
+			expected: []record{{ID: 1}},
+			wantErr:  true,
+			errMsgs:  []string{"+ [1] bee_test.record{ID: 2, CreatedAt: 0001-01-01 00:00:00 +0000 UTC, Meta: map[string]interface {}(nil), Items: []*bee_test.record(nil)}"},
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(bee.IgnoreType[time.Time]()) },
+		},
+	}
+
+	for _, test := range tests {
+		mt := &mockT{T: t}
+		b := bee.New(mt, bee.NoColor(), bee.ColumnWidth(200))
+		if test.using != nil {
+			b = test.using(b)
+		}
+		b.Equal(test.actual, test.expected)
+		if mt.wasErr != test.wantErr {
+			t.Errorf("%v, %v: %v != %v", test.actual, test.expected, mt.wasErr, test.wantErr)
+		}
+		if strings.Join(mt.errMsgs, "\n") != strings.Join(test.errMsgs, "\n") {
+			t.Errorf("%q != %q", mt.errMsgs, test.errMsgs)
+		}
+	}
+}
+
+type money struct {
+	cents    int64
+	currency string
+}
+
+type version struct {
+	major, minor int
+	label        string
+}
+
+func (v *version) Equal(w *version) bool {
+	return v.major == w.major && v.minor == w.minor
+}
+
+type event struct {
+	Name string
+	At   time.Time
+	at   time.Time
+}
+
+func TestComparer(t *testing.T) {
+	noon := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	now := time.Now()
+	sameCurrency := bee.Comparer(func(a, b money) bool {
+		return a.cents == b.cents && strings.EqualFold(a.currency, b.currency)
+	})
+	tests := []struct {
+		actual   any
+		expected any
+		wantErr  bool
+		errMsgs  []string
+		using    func(*bee.Bee) *bee.Bee
+	}{
+		{
+			actual:   now,
+			expected: now.Round(0),
+			wantErr:  false,
+		},
+		{
+			actual:   event{At: noon.In(time.FixedZone("CET", 3600))},
+			expected: event{At: noon},
+			wantErr:  false,
+		},
+		{
+			actual:   event{At: noon, at: noon.Add(time.Second)},
+			expected: event{At: noon, at: noon},
+			wantErr:  true,
+			errMsgs:  []string{"63839707201 != 63839707200 (.at.ext)"},
+		},
+		{
+			actual:   map[string]any{"at": noon},
+			expected: map[string]any{"at": noon.Local()},
+			wantErr:  false,
+		},
+		{
+			actual:   map[int]event{1: {At: now}},
+			expected: map[int]event{1: {At: now.Round(0)}},
+			wantErr:  false,
+		},
+		{
+			actual:   struct{ Events map[int]event }{map[int]event{1: {At: now}}},
+			expected: struct{ Events map[int]event }{map[int]event{1: {At: now.Round(0)}}},
+			wantErr:  false,
+		},
+		{
+			actual:   struct{ Payload any }{event{At: now}},
+			expected: struct{ Payload any }{event{At: now.Round(0)}},
+			wantErr:  false,
+		},
+		{
+			actual:   &version{1, 2, "a"},
+			expected: &version{1, 2, "b"},
+			wantErr:  false,
+		},
+		{
+			actual:   []*version{{1, 2, "a"}, nil},
+			expected: []*version{{1, 3, "a"}, {1, 2, "a"}},
+			wantErr:  true,
+			errMsgs:  []string{`- [0] &bee_test.version{major: 1, minor: 3, label: "a"}`, "+ [1] (*bee_test.version)(nil)"},
+		},
+		{
+			actual:   money{100, "eur"},
+			expected: money{100, "EUR"},
+			wantErr:  true,
+			errMsgs:  []string{"eur != EUR (.currency)"},
+		},
+		{
+			actual:   []money{{100, "eur"}},
+			expected: []money{{100, "EUR"}},
+			wantErr:  false,
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(sameCurrency) },
+		},
+		{
+			actual:   money{100, "eur"},
+			expected: money{200, "EUR"},
+			wantErr:  true,
+			errMsgs:  []string{`bee_test.money{cents: 100, currency: "eur"} != bee_test.money{cents: 200, currency: "EUR"}`},
+			using:    func(b *bee.Bee) *bee.Bee { return b.Using(sameCurrency) },
+		},
+		{
+			actual:   event{At: noon},
+			expected: event{At: noon.Add(time.Hour)},
+			wantErr:  false,
+			using: func(b *bee.Bee) *bee.Bee {
+				return b.Using(bee.Comparer(func(a, b time.Time) bool { return a.Year() == b.Year() }))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		mt := &mockT{T: t}
+		b := bee.New(mt, bee.NoColor(), bee.ColumnWidth(200))
+		if test.using != nil {
+			b = test.using(b)
+		}
+		b.Equal(test.actual, test.expected)
+		if mt.wasErr != test.wantErr {
+			t.Errorf("%v, %v: %v != %v", test.actual, test.expected, mt.wasErr, test.wantErr)
+		}
+		if strings.Join(mt.errMsgs, "\n") != strings.Join(test.errMsgs, "\n") {
+			t.Errorf("%q != %q", mt.errMsgs, test.errMsgs)
+		}
+	}
+}
+
+type account struct {
+	Name     string
+	Password string             `bee:"redact"`
+	Tokens   map[string]string  `bee:"redact"`
+	Balance  float64            `bee:"approx=1e-2"`
+	Rates    []float64          `bee:"approx=0.5"`
+	Session  *account           `bee:"-"`
+	Extra    map[string]float64 `bee:"redact,approx=1"`
+}
+
+type badTag struct {
+	Value float64 `bee:"approx=x"`
+}
+
+func TestTags(t *testing.T) {
+	tests := []struct {
+		actual   any
+		expected any
+		wantErr  bool
+		errMsgs  []string
+	}{
+		{
+			actual:   account{Name: "a", Balance: 1.001, Rates: []float64{1, 2}, Session: &account{Name: "x"}},
+			expected: account{Name: "a", Balance: 1.005, Rates: []float64{1.4, 2.3}, Session: &account{Name: "y"}},
+			wantErr:  false,
+		},
+		{
+			actual:   account{Balance: 1.0},
+			expected: account{Balance: 1.1},
+			wantErr:  true,
+			errMsgs:  []string{"1 != 1.1 (.Balance)"},
+		},
+		{
+			actual:   account{Name: "a", Password: "hunter2"},
+			expected: account{Name: "b", Password: "hunter3"},
+			wantErr:  true,
+			errMsgs:  []string{"a != b (.Name)", "<redacted> != <redacted> (.Password)"},
+		},
+		{
+			actual:   account{Tokens: map[string]string{"api": "secret1", "web": "secret3"}},
+			expected: account{Tokens: map[string]string{"api": "secret2"}},
+			wantErr:  true,
+			errMsgs:  []string{"<redacted> != <redacted> (.Tokens[<redacted>])", "unexpected key <redacted> (.Tokens)"},
+		},
+		{
+			actual:   account{Tokens: map[string]string{}},
+			expected: account{Tokens: map[string]string{"hunter2": "x"}},
+			wantErr:  true,
+			errMsgs:  []string{"missing key <redacted> (.Tokens)"},
+		},
+		{
+			actual:   account{Extra: map[string]float64{"a": 1}},
+			expected: account{Extra: map[string]float64{"a": 1.5}},
+			wantErr:  false,
+		},
+		{
+			actual:   []account{{Name: "a"}, {Name: "c", Password: "hunter2"}},
+			expected: []account{{Name: "a"}},
+			wantErr:  true,
+			errMsgs:  []string{`+ [1] bee_test.account{Name: "c", Password: <redacted>, Tokens: <redacted>, Balance: 0, Rates: []float64(nil), Session: (*bee_test.account)(nil), Extra: <redacted>}`},
+		},
+		{
+			actual:   badTag{Value: 1},
+			expected: badTag{Value: 1},
+			wantErr:  true,
+			errMsgs:  []string{`invalid tolerance "x" in tag of field Value (.Value)`},
+		},
+	}
+
+	for _, test := range tests {
+		mt := &mockT{T: t}
+		bee.New(mt, bee.NoColor(), bee.ColumnWidth(200)).Equal(test.actual, test.expected)
+		if mt.wasErr != test.wantErr {
+			t.Errorf("%v, %v: %v != %v", test.actual, test.expected, mt.wasErr, test.wantErr)
+		}
+		if strings.Join(mt.errMsgs, "\n") != strings.Join(test.errMsgs, "\n") {
+			t.Errorf("%q != %q", mt.errMsgs, test.errMsgs)
+		}
+		for _, msg := range append(mt.errMsgs, mt.logMsg) {
+			if strings.Contains(msg, "hunter") || strings.Contains(msg, "secret") {
+				t.Errorf("%q contains a redacted value", msg)
+			}
+		}
+	}
+}
+
+type response struct {
+	Status  int
+	Headers map[string]string
+	Body    *record
+	Tags    []string
+	Payload any
+}
+
+func TestMatches(t *testing.T) {
+	actual := response{
+		Status:  200,
+		Headers: map[string]string{"Content-Type": "application/json", "Date": "today"},
+		Body:    &record{ID: 7, Meta: map[string]any{"v": 1, "w": "x"}, Items: []*record{{ID: 1}, {ID: 2}}},
+		Tags:    []string{"a", "b"},
+		Payload: map[string]any{"ok": true, "n": 3},
+	}
+	tests := []struct {
+		expected any
+		wantErr  bool
+		errMsgs  []string
+	}{
+		{
+			expected: response{},
+			wantErr:  false,
+		},
+		{
+			expected: response{Status: 200, Headers: map[string]string{"Content-Type": "application/json"}},
+			wantErr:  false,
+		},
+		{
+			expected: response{Body: &record{ID: 7, Meta: map[string]any{"w": "x"}, Items: []*record{nil, {ID: 2}}}},
+			wantErr:  false,
+		},
+		{
+			expected: response{Payload: map[string]any{"ok": true}},
+			wantErr:  false,
+		},
+		{
+			expected: response{Status: 404, Headers: map[string]string{"Content-Type": "text/plain", "Server": "bee"}},
+			wantErr:  true,
+			errMsgs: []string{
+				"200 != 404 (.Status)",
+				"application/json != text/plain (.Headers[Content-Type])",
+				`missing key "Server" (.Headers)`,
+			},
+		},
+		{
+			expected: response{Body: &record{Items: []*record{{ID: 1}, {ID: 3}}}},
+			wantErr:  true,
+			errMsgs:  []string{"2 != 3 (**.Body.Items[1].ID)"},
+		},
+		{
+			expected: response{Tags: []string{"a"}},
+			wantErr:  true,
+			errMsgs:  []string{"+ .Tags[1] b"},
+		},
+		{
+			expected: response{Payload: map[string]any{"ok": false, "n": 4}},
+			wantErr:  true,
+			errMsgs:  []string{"3 != 4 (.Payload[n])"},
+		},
+		{
+			expected: &response{Status: 200},
+			wantErr:  true,
+			errMsgs:  []string{"bee_test.response != *bee_test.response"},
+		},
+	}
+
+	for _, test := range tests {
+		mt := &mockT{T: t}
+		ok := bee.New(mt, bee.NoColor()).Matches(actual, test.expected)
+		if ok == test.wantErr {
+			t.Errorf("%v == %v", ok, test.wantErr)
+		}
+		if mt.wasErr != test.wantErr {
+			t.Errorf("%v: %v != %v", test.expected, mt.wasErr, test.wantErr)
+		}
+		if strings.Join(mt.errMsgs, "\n") != strings.Join(test.errMsgs, "\n") {
+			t.Errorf("%q != %q", mt.errMsgs, test.errMsgs)
+		}
+	}
+}
+
+type node struct {
+	Value int
+	Next  *node
+	Prev  *node
+}
+
+func list(values ...int) *node {
+	var head, tail *node
+	for _, v := range values {
+		n := &node{Value: v, Prev: tail}
+		if tail == nil {
+			head = n
+		} else {
+			tail.Next = n
+		}
+		tail = n
+	}
+	return head
+}
+
+func ring(values ...int) *node {
+	head := list(values...)
+	tail := head
+	for tail.Next != nil {
+		tail = tail.Next
+	}
+	tail.Next = head
+	head.Prev = tail
+	return head
+}
+
+type dag struct {
+	Value int
+	L, R  *dag
+}
+
+func shared(depth, leaf int) *dag {
+	if depth == 0 {
+		return &dag{Value: leaf}
+	}
+	child := shared(depth-1, leaf)
+	return &dag{Value: depth, L: child, R: child}
+}
+
+func TestEqualShared(t *testing.T) {
+	mt := &mockT{T: t}
+	b := bee.New(mt, bee.NoColor())
+	if !b.Equal(shared(64, 0), shared(64, 0)) {
+		t.Errorf("unexpected errors %q", mt.errMsgs)
+	}
+	if b.NotEqual(shared(64, 0), shared(64, 0)) {
+		t.Error("expected error")
+	}
+	mt = &mockT{T: t}
+	if bee.New(mt, bee.NoColor()).Equal(shared(3, 0), shared(3, 1)) {
+		t.Error("expected error")
+	}
+	if len(mt.errMsgs) != 8 || mt.errMsgs[0] != "0 != 1 (****.L.L.L.Value)" {
+		t.Errorf("%q does not report every path to the difference", mt.errMsgs)
+	}
+}
+
+func TestEqualMapKeyOrder(t *testing.T) {
+	mt := &mockT{T: t}
+	if bee.New(mt, bee.NoColor()).Equal(map[int]int{1: 1, 2: 2, 10: 10}, map[int]int{1: 0, 2: 0, 10: 0}) {
+		t.Error("expected error")
+	}
+	want := []string{"1 != 0 ([1])", "2 != 0 ([2])", "10 != 0 ([10])"}
+	if !slices.Equal(mt.errMsgs, want) {
+		t.Errorf("%q != %q", mt.errMsgs, want)
+	}
+}
+
+func TestEqualCycles(t *testing.T) {
+	selfRef := func() []any {
+		s := []any{nil}
+		s[0] = s
+		return s
+	}
+
+	tests := []struct {
+		actual   any
+		expected any
+		wantErr  bool
+		errMsg   matchText
+	}{
+		{
+			actual:   list(1, 2, 3),
+			expected: list(1, 2, 3),
+			wantErr:  false,
+			errMsg:   exact(""),
+		},
+		{
+			actual:   list(1, 2, 3),
+			expected: list(1, 2, 4),
+			wantErr:  true,
+			errMsg:   exact("3 != 4 (***.Next.Next.Value)"),
+		},
+		{
+			actual:   ring(1, 2),
+			expected: ring(1, 2),
+			wantErr:  false,
+			errMsg:   exact(""),
+		},
+		{
+			actual:   ring(1),
+			expected: ring(1, 1),
+			wantErr:  true,
+			errMsg:   exact("<cycle to root> != &bee_test.node{Value: 1, Next: &bee_test.node{Value: 1, N... (*.Prev)"),
+		},
+		{
+			actual:   selfRef(),
+			expected: selfRef(),
+			wantErr:  false,
+			errMsg:   exact(""),
+		},
+	}
+
+	for _, test := range tests {
+		mockT := &mockT{T: t}
+		bee := bee.New(mockT, bee.NoColor())
+		bee.Equal(test.actual, test.expected)
+		if mockT.wasErr != test.wantErr {
+			if test.wantErr {
+				t.Error("expected error")
+			} else {
+				t.Error("expected no error")
+			}
+		}
+		if !test.errMsg(mockT.errMsg) {
+			t.Errorf("unexpected error message: %q", mockT.errMsg)
+		}
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	actual := `SELECT id, name
+FROM users
+WHERE active = true
+  AND tenant = 'acme'
+ORDER BY name
+LIMIT 10
+OFFSET 0
+-- page 1
+-- generated`
+	expected := `SELECT id, name, email
+FROM users
+WHERE active = true
+  AND tenant = 'acme'
+ORDER BY name
+LIMIT 10
+OFFSET 0
+-- page 1
+-- generated
+`
+	logMsg := `
+--- expected
++++ actual
+@@ -1,4 +1,4 @@
+-SELECT id, name, email
++SELECT id, name
+ FROM users
+ WHERE active = true
+   AND tenant = 'acme'
+@@ -7,4 +7,3 @@
+ OFFSET 0
+ -- page 1
+ -- generated
+-`
+	mt := &mockT{T: t}
+	bee.New(mt, bee.NoColor()).Equal(actual, expected)
+	if !mt.wasErr {
+		t.Error("expected error")
+	}
+	if mt.logMsg != logMsg {
+		t.Errorf("%q != %q", mt.logMsg, logMsg)
+	}
+
+	mt = &mockT{T: t}
+	bee.New(mt).Equal("a\nb", "a\nc")
+	logMsg = "\n" +
+		"\x1b[38;2;18;181;32m--- expected\x1b[0m\n" +
+		"\x1b[38;2;250;40;25m+++ actual\x1b[0m\n" +
+		"\x1b[38;2;2;118;250m@@ -1,2 +1,2 @@\x1b[0m\n" +
+		" a\n" +
+		"\x1b[38;2;18;181;32m-c\x1b[0m\n" +
+		"\x1b[38;2;250;40;25m+b\x1b[0m"
+	if mt.logMsg != logMsg {
+		t.Errorf("%q != %q", mt.logMsg, logMsg)
+	}
+
+	for _, assert := range []func(b *bee.Bee){
+		func(b *bee.Bee) { b.NotEqual("first\nsecond", "first\nsecond") },
+		func(b *bee.Bee) { bee.Ne(b, "first\nsecond", "first\nsecond") },
+	} {
+		mt = &mockT{T: t}
+		assert(bee.New(mt, bee.NoColor()))
+		if !mt.wasErr {
+			t.Error("expected error")
+		}
+		if strings.Contains(mt.logMsg, "--- expected") || strings.Count(mt.logMsg, "second") != 2 {
+			t.Errorf("%q does not show both values", mt.logMsg)
+		}
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		actual   string
+		expected string
+		errMsg   string
+		newBee   newBee
+	}{
+		{
+			actual:   "token=abcXef",
+			expected: "token=abcYef",
+			errMsg:   "token=abcXef != token=abcYef",
+			newBee:   newBeeWithoutColor(),
+		},
+		{
+			actual:   "token=abcXef",
+			expected: "token=abcYef",
+			errMsg: "\x1b[38;2;1;1;1mtoken=abc\x1b[0m\x1b[38;2;1;1;1;48;2;68;68;68mX\x1b[0m\x1b[38;2;1;1;1mef\x1b[0m != " +
+				"\x1b[38;2;2;2;2mtoken=abc\x1b[0m\x1b[38;2;2;2;2;48;2;68;68;68mY\x1b[0m\x1b[38;2;2;2;2mef\x1b[0m",
+			newBee: newBeeWithCustomColor(),
+		},
+		{
+			actual:   "token=abc",
+			expected: "token=abcdef",
+			errMsg: "\x1b[38;2;1;1;1mtoken=abc\x1b[0m != " +
+				"\x1b[38;2;2;2;2mtoken=abc\x1b[0m\x1b[38;2;2;2;2;48;2;68;68;68mdef\x1b[0m",
+			newBee: newBeeWithCustomColor(),
+		},
+		{
+			actual:   strings.Repeat("-", 100) + "token=abcXef" + strings.Repeat("-", 100),
+			expected: strings.Repeat("-", 100) + "token=abcYef" + strings.Repeat("-", 100),
+			errMsg:   "..." + strings.Repeat("-", 7) + "token=abcXef" + strings.Repeat("-", 23) + "... != ..." + strings.Repeat("-", 7) + "token=abcYef" + strings.Repeat("-", 23) + "...",
+			newBee:   func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.ColumnWidth(48)) },
+		},
+		{
+			actual:   strings.Repeat("-", 100) + "X",
+			expected: strings.Repeat("-", 100) + "Y",
+			errMsg:   "..." + strings.Repeat("-", 44) + "X != ..." + strings.Repeat("-", 44) + "Y",
+			newBee:   func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.ColumnWidth(48)) },
+		},
+	}
+
+	for _, test := range tests {
+		mockT := &mockT{T: t}
+		bee := test.newBee(mockT)
+		bee.Equal(test.actual, test.expected)
+		if !mockT.wasErr {
+			t.Error("expected error")
+		}
+		if mockT.errMsg != test.errMsg {
+			t.Errorf("%q != %q", mockT.errMsg, test.errMsg)
+		}
+	}
+}
+
+func TestUnicode(t *testing.T) {
+	tests := []struct {
+		actual   string
+		expected string
+		errMsg   string
+	}{
+		{
+			actual:   "日本語のテキストです",
+			expected: "abc",
+			errMsg:   "日本語... != abc",
+		},
+		{
+			actual:   "héllo wörld, ünïcode",
+			expected: "abc",
+			errMsg:   "héllo w... != abc",
+		},
+		{
+			actual:   "e\u0301e\u0301e\u0301e\u0301e\u0301e\u0301e\u0301e\u0301e\u0301e\u0301e\u0301",
+			expected: "abc",
+			errMsg:   "e\u0301e\u0301e\u0301e\u0301e\u0301e\u0301e\u0301... != abc",
+		},
+		{
+			actual:   strings.Repeat("👨‍👩‍👧", 6),
+			expected: "abc",
+			errMsg:   "👨‍👩‍👧👨‍👩‍👧👨‍👩‍👧... != abc",
+		},
+		{
+			actual:   strings.Repeat("日", 50) + "X",
+			expected: strings.Repeat("日", 50) + "Y",
+			errMsg:   "..." + strings.Repeat("日", 3) + "X != ..." + strings.Repeat("日", 3) + "Y",
+		},
+		{
+			actual:   "👨‍👩‍👧 family",
+			expected: "👨‍👩‍👦 family",
+			errMsg:   "👨‍👩‍👧 family != 👨‍👩‍👦 family",
+		},
+	}
+
+	for _, test := range tests {
+		mockT := &mockT{T: t}
+		bee := bee.New(mockT, bee.NoColor(), bee.ColumnWidth(10))
+		bee.Equal(test.actual, test.expected)
+		if !mockT.wasErr {
+			t.Error("expected error")
+		}
+		if !utf8.ValidString(mockT.errMsg) {
+			t.Errorf("invalid UTF-8: %q", mockT.errMsg)
+		}
+		if mockT.errMsg != test.errMsg {
+			t.Errorf("%q != %q", mockT.errMsg, test.errMsg)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	type inner struct {
+		Tags map[string]int
+	}
+	type outer struct {
+		Name  string
+		Inner *inner
+		Items []inner
+		Any   any
+		Err   error
+	}
+	deep := []any{[]any{[]any{[]any{1}}}}
+
+	tests := []struct {
+		value  any
+		errMsg string
+		newBee newBee
+	}{
+		{
+			value:  outer{Name: "a", Inner: &inner{Tags: map[string]int{"b": 2, "a": 1}}},
+			errMsg: `bee_test.outer{Name: "a", Inner: &bee_test.inner{Tags: map[string]int{"a": 1, "b": 2}}, Items: []bee_test.inner(nil), Any: <nil>, Err: <nil>}`,
+			newBee: func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.ColumnWidth(200)) },
+		},
+		{
+			value:  outer{Items: []inner{{}}, Any: 1.5, Err: errors.New("oops")},
+			errMsg: `bee_test.outer{Name: "", Inner: (*bee_test.inner)(nil), Items: []bee_test.inner{{Tags: map[string]int(nil)}}, Any: 1.5, Err: oops}`,
+			newBee: func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.ColumnWidth(200)) },
+		},
+		{
+			value:  map[int]int{10: 10, 2: 2, 1: 1, -1: -1},
+			errMsg: `map[int]int{-1: -1, 1: 1, 2: 2, 10: 10}`,
+			newBee: func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.ColumnWidth(200)) },
+		},
+		{
+			value:  map[float64]bool{10: true, 2.5: false, 1: true},
+			errMsg: `map[float64]bool{1: true, 2.5: false, 10: true}`,
+			newBee: func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.ColumnWidth(200)) },
+		},
+		{
+			value:  ring(1),
+			errMsg: `&bee_test.node{Value: 1, Next: <cycle>, Prev: <cycle>}`,
+			newBee: func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.ColumnWidth(200)) },
+		},
+		{
+			value:  deep,
+			errMsg: `[]interface {}{[]interface {}{[]interface {}{...}}}`,
+			newBee: func(mt *mockT) *bee.Bee { return bee.New(mt, bee.NoColor(), bee.ColumnWidth(200), bee.MaxDepth(2)) },
+		},
+	}
+
+	for _, test := range tests {
+		mockT := &mockT{T: t}
+		bee := test.newBee(mockT)
+		bee.NotEqual(test.value, test.value)
+		want := test.errMsg + " == " + test.errMsg
+		if mockT.errMsg != want {
+			t.Errorf("%q != %q", mockT.errMsg, want)
+		}
+	}
+
+	mt := &mockT{T: t}
+	bee.New(mt, bee.NoColor(), bee.ColumnWidth(40)).NotEqual(
+		outer{Name: "a", Items: []inner{{Tags: map[string]int{"x": 1}}}},
+		outer{Name: "a", Items: []inner{{Tags: map[string]int{"x": 1}}}},
+	)
+	logMsg := `
+bee_test.outer{                          bee_test.outer{                        
+  Name: "a",                               Name: "a",                           
+  Inner: (*bee_test.inner)(nil),           Inner: (*bee_test.inner)(nil),       
+  Items: []bee_test.inner{                 Items: []bee_test.inner{             
+    {                                        {                                  
+      Tags: map[string]int{                    Tags: map[string]int{            
+        "x": 1,                                  "x": 1,                        
+      },                                       },                               
+    },                                       },                                 
+  },                                       },                                   
+  Any: <nil>,                              Any: <nil>,                          
+  Err: <nil>,                              Err: <nil>,                          
+}                                        }                                      `
+	if mt.logMsg != logMsg {
+		t.Errorf("%q != %q", mt.logMsg, logMsg)
+	}
+}
+
+func TestRequire(t *testing.T) {
+	tests := []struct {
+		assert   func(b *bee.Bee)
+		wantFail bool
+		errMsg   string
+	}{
+		{
+			assert:   func(b *bee.Bee) { b.Nil(nil) },
+			wantFail: false,
+			errMsg:   "",
+		},
+		{
+			assert:   func(b *bee.Bee) { b.Nil(errors.New("oops")) },
+			wantFail: true,
+			errMsg:   "oops != <nil>",
+		},
+		{
+			assert:   func(b *bee.Bee) { b.NotNil(nil) },
+			wantFail: true,
+			errMsg:   "<nil> == <nil>",
+		},
+		{
+			assert:   func(b *bee.Bee) { b.True(false) },
+			wantFail: true,
+			errMsg:   "false != true",
+		},
+		{
+			assert:   func(b *bee.Bee) { b.False(false) },
+			wantFail: false,
+			errMsg:   "",
+		},
+		{
+			assert:   func(b *bee.Bee) { b.Equal([]int{1, 2}, []int{3, 2}) },
+			wantFail: true,
+			errMsg:   "1 != 3 ([0])",
+		},
+		{
+			assert:   func(b *bee.Bee) { b.NotEqual(1, 1) },
+			wantFail: true,
+			errMsg:   "1 == 1",
+		},
+		{
+			assert:   func(b *bee.Bee) { bee.Lt(b, 2, 1) },
+			wantFail: true,
+			errMsg:   "2 >= 1",
+		},
+	}
+
+	for _, test := range tests {
+		mt := &mockT{T: t}
+		test.assert(bee.New(mt, bee.NoColor()).Require())
+		if mt.wasFail != test.wantFail {
+			if test.wantFail {
+				t.Error("expected fail")
+			} else {
+				t.Error("expected no fail")
+			}
+		}
+		if mt.errMsg != test.errMsg {
+			t.Errorf("%q != %q", mt.errMsg, test.errMsg)
+		}
+
+		mt = &mockT{T: t}
+		test.assert(bee.New(mt, bee.NoColor(), bee.FailFast()))
+		if mt.wasFail != test.wantFail {
+			t.Errorf("FailFast(): %v != %v", mt.wasFail, test.wantFail)
+		}
+
+		mt = &mockT{T: t}
+		test.assert(bee.New(mt, bee.NoColor()))
+		if mt.wasFail {
+			t.Error("expected no fail")
+		}
+	}
+}
+
+func TestMessage(t *testing.T) {
+	tests := []struct {
+		assert func(b *bee.Bee)
+		errMsg string
+		newBee newBee
+	}{
+		{
+			assert: func(b *bee.Bee) { b.True(false, "user %d should be active", 3) },
+			errMsg: "false != true: user 3 should be active",
+			newBee: newBeeWithoutColor(),
+		},
+		{
+			assert: func(b *bee.Bee) { b.False(true, "100%") },
+			errMsg: "true != false: 100%",
+			newBee: newBeeWithoutColor(),
+		},
+		{
+			assert: func(b *bee.Bee) { b.Nil(errors.New("oops"), 42, "is the answer") },
+			errMsg: "oops != <nil>: 42 is the answer",
+			newBee: newBeeWithoutColor(),
+		},
+		{
+			assert: func(b *bee.Bee) { b.NotNil(nil, "response") },
+			errMsg: "<nil> == <nil>: response",
+			newBee: newBeeWithoutColor(),
+		},
+		{
+			assert: func(b *bee.Bee) { b.Equal(struct{ A int }{1}, struct{ A int }{2}, "request %s", "r1") },
+			errMsg: "1 != 2 (.A): request r1",
+			newBee: newBeeWithoutColor(),
+		},
+		{
+			assert: func(b *bee.Bee) { b.NotEqual(1, 1, "ids") },
+			errMsg: "1 == 1: ids",
+			newBee: newBeeWithoutColor(),
+		},
+		{
+			assert: func(b *bee.Bee) { bee.Lt(b, 2, 1, "retries") },
+			errMsg: "2 >= 1: retries",
+			newBee: newBeeWithoutColor(),
+		},
+		{
+			assert: func(b *bee.Bee) { b.With("tenant", "acme").With("user", 3).True(false) },
+			errMsg: "false != true [tenant=acme, user=3]",
+			newBee: newBeeWithoutColor(),
+		},
+		{
+			assert: func(b *bee.Bee) { b.With("tenant", "acme").Equal([]int{1}, []int{2}, "retry %d", 2) },
+			errMsg: "1 != 2 ([0]): retry 2 [tenant=acme]",
+			newBee: newBeeWithoutColor(),
+		},
+		{
+			assert: func(b *bee.Bee) { b.With("tenant", "acme").True(false, "user %d", 3) },
+			errMsg: "\x1b[38;2;250;40;25mfalse\x1b[0m != \x1b[38;2;18;181;32mtrue\x1b[0m: " +
+				"\x1b[38;2;250;189;47muser 3\x1b[0m [\x1b[38;2;250;189;47mtenant=acme\x1b[0m]",
+			newBee: newBeeWithDefaultColor(),
+		},
+		{
+			assert: func(b *bee.Bee) { b.Using(bee.MessageColor(4, 4, 4)).True(false, "user %d", 3) },
+			errMsg: "\x1b[38;2;1;1;1mfalse\x1b[0m != \x1b[38;2;2;2;2mtrue\x1b[0m: \x1b[38;2;4;4;4muser 3\x1b[0m",
+			newBee: newBeeWithCustomColor(),
+		},
+	}
+
+	for _, test := range tests {
+		mockT := &mockT{T: t}
+		test.assert(test.newBee(mockT))
+		if !mockT.wasErr {
+			t.Error("expected error")
+		}
+		if mockT.errMsg != test.errMsg {
+			t.Errorf("%q != %q", mockT.errMsg, test.errMsg)
+		}
+	}
+}
+
+func TestResult(t *testing.T) {
+	tests := []struct {
+		assert func(b *bee.Bee) bool
+		want   bool
+	}{
+		{
+			assert: func(b *bee.Bee) bool { return b.Nil(nil) },
+			want:   true,
+		},
+		{
+			assert: func(b *bee.Bee) bool { return b.Nil(1) },
+			want:   false,
+		},
+		{
+			assert: func(b *bee.Bee) bool { return b.NotNil(1) },
+			want:   true,
+		},
+		{
+			assert: func(b *bee.Bee) bool { return b.NotNil(nil) },
+			want:   false,
+		},
+		{
+			assert: func(b *bee.Bee) bool { return b.True(true) },
+			want:   true,
+		},
+		{
+			assert: func(b *bee.Bee) bool { return b.True(false) },
+			want:   false,
+		},
+		{
+			assert: func(b *bee.Bee) bool { return b.False(false) },
+			want:   true,
+		},
+		{
+			assert: func(b *bee.Bee) bool { return b.False(true) },
+			want:   false,
+		},
+		{
+			assert: func(b *bee.Bee) bool { return b.Equal(map[string]int{"a": 1}, map[string]int{"a": 1}) },
+			want:   true,
+		},
+		{
+			assert: func(b *bee.Bee) bool { return b.Equal(map[string]int{"a": 1}, map[string]int{"a": 2}) },
+			want:   false,
+		},
+		{
+			assert: func(b *bee.Bee) bool { return b.Equal([]int{1, 2}, []int{2}) },
+			want:   false,
+		},
+		{
+			assert: func(b *bee.Bee) bool { return b.NotEqual(1, 2) },
+			want:   true,
+		},
+		{
+			assert: func(b *bee.Bee) bool { return b.NotEqual(1, 1) },
+			want:   false,
+		},
+		{
+			assert: func(b *bee.Bee) bool { return bee.Eq(b, 1, 1) },
+			want:   true,
+		},
+		{
+			assert: func(b *bee.Bee) bool { return bee.Ne(b, 1, 1) },
+			want:   false,
+		},
+		{
+			assert: func(b *bee.Bee) bool { return bee.Lt(b, 1, 2) },
+			want:   true,
+		},
+		{
+			assert: func(b *bee.Bee) bool { return bee.Ge(b, 1, 2) },
+			want:   false,
+		},
+	}
+
+	for i, test := range tests {
+		mockT := &mockT{T: t}
+		got := test.assert(bee.New(mockT, bee.NoColor()))
+		if got != test.want {
+			t.Errorf("%d: %v != %v", i, got, test.want)
+		}
+		if got == mockT.wasErr {
+			t.Errorf("%d: %v == %v", i, got, mockT.wasErr)
+		}
+	}
+}
+
 func TestExpand(t *testing.T) {
 	actual := "Lorem ipsum dolor sit amet, consectetur adipiscing elit. Nullam in tortor non enim vehicula malesuada at ut lorem. Curabitur placerat sem id pellentesque posuere. Proin porttitor ante a laoreet fringilla. Nam bibendum velit posuere est feugiat, condimentum ornare est malesuada. In sed consectetur mi. Vestibulum ante lectus, vulputate dapibus maximus ac, tincidunt sed nisl. Morbi eu felis faucibus, imperdiet quam in, accumsan libero. Phasellus lacinia mauris arcu, nec aliquam mi laoreet pharetra. Pellentesque non lorem magna."
 	expected := "Lorem ipsum dolor sit amet, consectetur adipiscing elit. Fusce cursus eros neque, in varius tellus bibendum ut. Nunc blandit eu lorem non mollis. Lorem ipsum dolor sit amet, consectetur adipiscing elit. Phasellus condimentum eros euismod mattis placerat. Donec id faucibus dolor. Interdum et malesuada fames ac ante ipsum primis in faucibus. Donec in velit vitae lorem venenatis consectetur non et odio. Maecenas odio leo, tristique nec feugiat ac, blandit et nisl. Phasellus faucibus enim eu elit rhoncus mollis. Etiam nec rutrum orci. Ut eget pretium nisi."
-	errMsg := "Lorem ipsum dolor sit amet, consectetur adipiscing elit. ... != Lorem ipsum dolor sit amet, consectetur adipiscing elit. ..."
+	errMsg := "...ur adipiscing elit. Nullam in tortor non enim vehicula... != ...ur adipiscing elit. Fusce cursus eros neque, in varius..."
 	logMsg := `
 Lorem ipsum dolor sit amet, consectetur adipiscing elit.     Lorem ipsum dolor sit amet, consectetur adipiscing elit.   
 Nullam in tortor non enim vehicula malesuada at ut lorem.    Fusce cursus eros neque, in varius tellus bibendum ut. Nunc
@@ -2,6 +2,9 @@ package bee
 
 import (
 	"flag"
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/charmbracelet/lipgloss"
@@ -19,6 +22,10 @@ func init() {
 type Bee struct {
 	tb  testing.TB
 	cfg config
+	// context holds the key=value pairs added to every failure.
+	context []string
+	// message explains the failure of the current assertion.
+	message string
 }
 
 func New(tb testing.TB, opts ...option) *Bee {
@@ -32,3 +39,47 @@ func New(tb testing.TB, opts ...option) *Bee {
 	}
 	return &Bee{tb: tb, cfg: cfg}
 }
+
+// Using returns a Bee that applies opts on top of the options of b,
+// for example to relax the float tolerance of a single assertion.
+func (b *Bee) Using(opts ...option) *Bee {
+	c := *b
+	for _, opt := range opts {
+		opt(&c.cfg)
+	}
+	return &c
+}
+
+// Require returns a Bee that stops the test after the first failed assertion.
+func (b *Bee) Require() *Bee {
+	return b.Using(FailFast())
+}
+
+// With returns a Bee that adds key=value to the context of every failure.
+func (b *Bee) With(key string, value any) *Bee {
+	c := *b
+	c.context = append(slices.Clip(b.context), fmt.Sprintf("%s=%s", key, b.format(value, false)))
+	return &c
+}
+
+// annotate returns a Bee that adds the message formatted from msgAndArgs
+// to the failures of an assertion.
+func (b *Bee) annotate(msgAndArgs []any) *Bee {
+	if len(msgAndArgs) == 0 {
+		return b
+	}
+	c := *b
+	c.message = message(msgAndArgs)
+	return &c
+}
+
+func message(msgAndArgs []any) string {
+	format, ok := msgAndArgs[0].(string)
+	switch {
+	case !ok:
+		return strings.TrimSuffix(fmt.Sprintln(msgAndArgs...), "\n")
+	case len(msgAndArgs) == 1:
+		return format
+	}
+	return fmt.Sprintf(format, msgAndArgs[1:]...)
+}
@@ -0,0 +1,212 @@
+package bee
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const empty = "<empty>"
+
+// Contains checks that container has element. container can be a string, in
+// which case element must be a substring, a slice or an array, in which case
+// element must equal one of its elements, or a map, in which case element
+// must equal one of its keys.
+func (b *Bee) Contains(container, element any, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	found, _, ok := b.find(container, element)
+	if ok && !found {
+		b.errorLine(container, element, "", "does not contain")
+	}
+	return b.done(ok && found)
+}
+
+func (b *Bee) NotContains(container, element any, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	found, what, ok := b.find(container, element)
+	if ok && found {
+		b.errorLine(container, element, what, "contains")
+	}
+	return b.done(ok && !found)
+}
+
+// find reports whether container has element and where it was found. The
+// last result is false if container cannot have elements.
+func (b *Bee) find(container, element any) (bool, string, bool) {
+	b.tb.Helper()
+	c := reflect.ValueOf(container)
+	e := reflect.ValueOf(element)
+	quiet := b.newComparison(true)
+	switch c.Kind() {
+	case reflect.String:
+		if e.Kind() != reflect.String {
+			b.errorType(element, "is not a string")
+			return false, "", false
+		}
+		return strings.Contains(c.String(), e.String()), "", true
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < c.Len(); i++ {
+			what := fmt.Sprintf("[%d]", i)
+			if quiet.equals(c.Index(i), e, what) {
+				return true, what, true
+			}
+		}
+		return false, "", true
+	case reflect.Map:
+		for _, k := range sortedKeys(c) {
+			what := fmt.Sprintf("[%v]", k)
+			if quiet.equals(k, e, what) {
+				return true, what, true
+			}
+		}
+		return false, "", true
+	}
+	b.errorType(container, "is not a string, slice, array or map")
+	return false, "", false
+}
+
+func (b *Bee) Len(value any, length int, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	v := reflect.ValueOf(value)
+	if !hasLen(v) {
+		b.errorType(value, "has no length")
+		return b.done(false)
+	}
+	ok := v.Len() == length
+	if !ok {
+		b.errorLine(v.Len(), length, "len()", "!=")
+	}
+	return b.done(ok)
+}
+
+// Empty checks that value has a length of zero, or that it is the zero value
+// of its type if it has no length.
+func (b *Bee) Empty(value any, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	ok := isEmpty(value)
+	if !ok {
+		b.errorNotEquals(value, empty, "")
+	}
+	return b.done(ok)
+}
+
+func (b *Bee) NotEmpty(value any, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	ok := !isEmpty(value)
+	if !ok {
+		b.errorEquals(value, empty, "")
+	}
+	return b.done(ok)
+}
+
+func isEmpty(value any) bool {
+	v := reflect.ValueOf(value)
+	switch {
+	case !v.IsValid():
+		return true
+	case hasLen(v):
+		return v.Len() == 0
+	}
+	return v.IsZero()
+}
+
+func hasLen(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+		return true
+	}
+	return false
+}
+
+// ElementsMatch checks that actual and expected have the same elements,
+// regardless of their order. Elements only present in actual are reported
+// with their index in actual, those only in expected with their index in
+// expected.
+func (b *Bee) ElementsMatch(actual, expected any, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	actualValue := reflect.ValueOf(actual)
+	expectedValue := reflect.ValueOf(expected)
+	if !isList(actualValue) || !isList(expectedValue) {
+		for _, value := range []any{actual, expected} {
+			if !isList(reflect.ValueOf(value)) {
+				b.errorType(value, "is not a slice or array")
+			}
+		}
+		return b.done(false)
+	}
+	quiet := b.newComparison(true)
+	equal := make([][]bool, actualValue.Len())
+	for i := range equal {
+		equal[i] = make([]bool, expectedValue.Len())
+		for j := range equal[i] {
+			equal[i][j] = quiet.equals(actualValue.Index(i), expectedValue.Index(j), fmt.Sprintf("[%d]", i))
+		}
+	}
+	matches := match(equal, expectedValue.Len())
+	ok := true
+	for j, i := range matches {
+		if i < 0 {
+			b.errorElement("-", b.cfg.expectedTextStyle, fmt.Sprintf("[%d]", j), expectedValue.Index(j))
+			ok = false
+		}
+	}
+	matched := make([]bool, actualValue.Len())
+	for _, i := range matches {
+		if i >= 0 {
+			matched[i] = true
+		}
+	}
+	for i, m := range matched {
+		if !m {
+			b.errorElement("+", b.cfg.actualTextStyle, fmt.Sprintf("[%d]", i), actualValue.Index(i))
+			ok = false
+		}
+	}
+	return b.done(ok)
+}
+
+// match pairs as many actual elements with equal expected elements as
+// possible, given which of them are equal. The result holds the index of the
+// actual element paired with each of the n expected elements, or -1.
+func match(equal [][]bool, n int) []int {
+	matches := make([]int, n)
+	for j := range matches {
+		matches[j] = -1
+	}
+	// augment looks for a path that pairs actual element i by moving the
+	// elements already paired to other expected elements.
+	var augment func(i int, seen []bool) bool
+	augment = func(i int, seen []bool) bool {
+		for j, eq := range equal[i] {
+			if !eq || seen[j] {
+				continue
+			}
+			seen[j] = true
+			if matches[j] < 0 || augment(matches[j], seen) {
+				matches[j] = i
+				return true
+			}
+		}
+		return false
+	}
+	for i := range equal {
+		augment(i, make([]bool, n))
+	}
+	return matches
+}
+
+func isList(v reflect.Value) bool {
+	return v.Kind() == reflect.Array || v.Kind() == reflect.Slice
+}
+
+// errorType reports that value cannot be used by an assertion.
+func (b *Bee) errorType(value any, problem string) {
+	b.tb.Helper()
+	b.errorf("", "%s %s", b.cfg.actualTextStyle.Render(fmt.Sprintf("%T", value)), problem)
+}
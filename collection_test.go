@@ -0,0 +1,228 @@
+package bee_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/danielrenes/bee"
+)
+
+type item struct {
+	ID   int
+	Name string
+}
+
+func TestCollections(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		assert  func(b *bee.Bee) bool
+		wantErr bool
+		errMsgs []string
+	}{
+		{
+			assert:  func(b *bee.Bee) bool { return b.Contains("hello world", "o w") },
+			wantErr: false,
+			errMsgs: nil,
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Contains("hello world", "xyz") },
+			wantErr: true,
+			errMsgs: []string{"hello world does not contain xyz"},
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Contains("a\nb", "zz") },
+			wantErr: true,
+			errMsgs: []string{"ab does not contain zz"},
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.NotContains("hello", "hel") },
+			wantErr: true,
+			errMsgs: []string{"hello contains hel"},
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Contains("hello world", 1) },
+			wantErr: true,
+			errMsgs: []string{"int is not a string"},
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Contains([]item{{1, "a"}, {2, "b"}}, item{2, "b"}) },
+			wantErr: false,
+			errMsgs: nil,
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Contains([2]int{1, 2}, 3) },
+			wantErr: true,
+			errMsgs: []string{"[2]int{1, 2} does not contain 3"},
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Contains([]event{{At: now}}, event{At: now.Round(0)}) },
+			wantErr: false,
+			errMsgs: nil,
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Contains(map[event]int{{At: now}: 1}, event{At: now.Round(0)}) },
+			wantErr: false,
+			errMsgs: nil,
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Contains(map[string]int{"a": 1}, "a") },
+			wantErr: false,
+			errMsgs: nil,
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Contains(map[string]int{"a": 1}, 1) },
+			wantErr: true,
+			errMsgs: []string{`map[string]int{"a": 1} does not contain 1`},
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Contains(42, 4) },
+			wantErr: true,
+			errMsgs: []string{"int is not a string, slice, array or map"},
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.NotContains([]int{1, 2, 3}, 4) },
+			wantErr: false,
+			errMsgs: nil,
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.NotContains([]int{1, 2, 3}, 2) },
+			wantErr: true,
+			errMsgs: []string{"[]int{1, 2, 3} contains 2 ([1])"},
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.NotContains(map[string]int{"a": 1}, "a", "user %d", 7) },
+			wantErr: true,
+			errMsgs: []string{`map[string]int{"a": 1} contains a ([a]): user 7`},
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Len([]int{1, 2}, 2) },
+			wantErr: false,
+			errMsgs: nil,
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Len(map[int]int{1: 1}, 2) },
+			wantErr: true,
+			errMsgs: []string{"1 != 2 (len())"},
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Len(1.5, 2) },
+			wantErr: true,
+			errMsgs: []string{"float64 has no length"},
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Empty([]int{}) && b.Empty("") && b.Empty(nil) && b.Empty(item{}) },
+			wantErr: false,
+			errMsgs: nil,
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Empty([]int{1}) },
+			wantErr: true,
+			errMsgs: []string{"[]int{1} != <empty>"},
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.NotEmpty(map[int]int{1: 1}) && b.NotEmpty(item{ID: 1}) },
+			wantErr: false,
+			errMsgs: nil,
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.NotEmpty(0) },
+			wantErr: true,
+			errMsgs: []string{"0 == <empty>"},
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.ElementsMatch([]int{3, 1, 2, 1}, []int{1, 1, 2, 3}) },
+			wantErr: false,
+			errMsgs: nil,
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.ElementsMatch([]int{3, 1, 4}, [3]int{1, 1, 3}) },
+			wantErr: true,
+			errMsgs: []string{"- [1] 1", "+ [2] 4"},
+		},
+		{
+			assert: func(b *bee.Bee) bool {
+				return b.ElementsMatch([]item{{1, "a"}, {2, "b"}}, []item{{2, "b"}, {1, "c"}})
+			},
+			wantErr: true,
+			errMsgs: []string{`- [1] bee_test.item{ID: 1, Name: "c"}`, `+ [0] bee_test.item{ID: 1, Name: "a"}`},
+		},
+		{
+			assert: func(b *bee.Bee) bool {
+				return b.ElementsMatch([]event{{Name: "a", At: now}}, [1]event{{Name: "a", At: now.Round(0)}})
+			},
+			wantErr: false,
+			errMsgs: nil,
+		},
+		{
+			assert: func(b *bee.Bee) bool {
+				return b.Using(bee.FloatAbsTolerance(0.06)).ElementsMatch([]float64{1.0, 1.1}, []float64{1.05, 1.0})
+			},
+			wantErr: false,
+			errMsgs: nil,
+		},
+		{
+			assert: func(b *bee.Bee) bool {
+				return b.Using(bee.FloatAbsTolerance(0.06)).ElementsMatch([]float64{1.0, 1.2}, []float64{1.05, 1.0})
+			},
+			wantErr: true,
+			errMsgs: []string{"- [1] 1", "+ [1] 1.2"},
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.ElementsMatch("abc", []string{"a"}) },
+			wantErr: true,
+			errMsgs: []string{"string is not a slice or array"},
+		},
+	}
+
+	for _, test := range tests {
+		mt := &mockT{T: t}
+		ok := test.assert(bee.New(mt, bee.NoColor()))
+		if ok == test.wantErr {
+			t.Errorf("%v == %v", ok, test.wantErr)
+		}
+		if mt.wasErr != test.wantErr {
+			if test.wantErr {
+				t.Error("expected error")
+			} else {
+				t.Error("expected no error")
+			}
+		}
+		if mt.wasLog {
+			t.Errorf("unexpected log %q", mt.logMsg)
+		}
+		if len(mt.errMsgs) != len(test.errMsgs) {
+			t.Errorf("%q != %q", mt.errMsgs, test.errMsgs)
+			continue
+		}
+		for i, errMsg := range mt.errMsgs {
+			if errMsg != test.errMsgs[i] {
+				t.Errorf("%q != %q", errMsg, test.errMsgs[i])
+			}
+		}
+	}
+}
+
+func TestContainsOutput(t *testing.T) {
+	tests := []struct {
+		container any
+		element   any
+	}{
+		{container: "hello", element: "help"},
+		{container: "first line\nsecond line", element: "third line"},
+	}
+
+	for _, test := range tests {
+		mt := &mockT{T: t}
+		bee.New(mt).Contains(test.container, test.element)
+		if !mt.wasErr {
+			t.Fatal("expected error")
+		}
+		if strings.Contains(mt.errMsg, "48;2;68;68;68") {
+			t.Errorf("%q is highlighted", mt.errMsg)
+		}
+		if mt.wasLog {
+			t.Errorf("unexpected log %q", mt.logMsg)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package bee
+
+import "reflect"
+
+// equalFunc compares two values of the same type.
+type equalFunc func(a, b any) bool
+
+// comparer returns how the values of type t are compared instead of by their
+// kind: with the Comparer registered for t, or with the Equal method of t.
+func (cfg *config) comparer(t reflect.Type) (equalFunc, bool) {
+	if equal, ok := cfg.comparers[t]; ok {
+		return equal, true
+	}
+	if t.Kind() == reflect.Interface {
+		return nil, false
+	}
+	m, ok := t.MethodByName("Equal")
+	if !ok || m.Type.NumIn() != 2 || m.Type.In(1) != t || m.Type.NumOut() != 1 || m.Type.Out(0).Kind() != reflect.Bool {
+		return nil, false
+	}
+	return func(a, b any) bool {
+		if t.Kind() == reflect.Pointer && (reflect.ValueOf(a).IsNil() || reflect.ValueOf(b).IsNil()) {
+			return a == b
+		}
+		return reflect.ValueOf(a).Method(m.Index).Call([]reflect.Value{reflect.ValueOf(b)})[0].Bool()
+	}, true
+}
+
+// compareWith compares actual and expected with the comparer of their type.
+// The result is false if there is no comparer for their type, or if their
+// values were obtained through unexported fields and cannot be passed to it.
+func (c *comparison) compareWith(actual, expected reflect.Value, what string) (equal, ok bool) {
+	c.b.tb.Helper()
+	if !actual.CanInterface() || !expected.CanInterface() {
+		return false, false
+	}
+	cmp, ok := c.b.cfg.comparer(actual.Type())
+	if !ok {
+		return false, false
+	}
+	a, e := actual.Interface(), expected.Interface()
+	if cmp(a, e) {
+		return true, true
+	}
+	return c.errorNotEquals(a, e, what), true
+}
@@ -1,26 +1,48 @@
 package bee
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"reflect"
+
+	"github.com/charmbracelet/lipgloss"
+)
 
 var (
-	defaultColumnWidth   = ColumnWidth(60)
-	defaultWhatColor     = WhatColor(2, 118, 250)
-	defaultExpectedColor = ExpectedColor(18, 181, 32)
-	defaultActualColor   = ActualColor(250, 40, 25)
-	defaultOpts          = []option{
+	defaultColumnWidth    = ColumnWidth(60)
+	defaultWhatColor      = WhatColor(2, 118, 250)
+	defaultExpectedColor  = ExpectedColor(18, 181, 32)
+	defaultActualColor    = ActualColor(250, 40, 25)
+	defaultHighlightColor = HighlightColor(68, 68, 68)
+	defaultMessageColor   = MessageColor(250, 189, 47)
+	defaultTolerance      = FloatAbsTolerance(1e-9)
+	defaultMaxDepth       = MaxDepth(10)
+	defaultOpts           = []option{
 		defaultColumnWidth,
 		defaultWhatColor,
 		defaultExpectedColor,
 		defaultActualColor,
+		defaultHighlightColor,
+		defaultMessageColor,
+		defaultTolerance,
+		defaultMaxDepth,
 	}
 )
 
 type config struct {
 	whatTextStyle       lipgloss.Style
+	messageTextStyle    lipgloss.Style
 	expectedTextStyle   lipgloss.Style
 	actualTextStyle     lipgloss.Style
 	expectedColumnStyle lipgloss.Style
 	actualColumnStyle   lipgloss.Style
+	highlightStyle      lipgloss.Style
+	drainChannels       bool
+	nanEqual            bool
+	tolerance           tolerance
+	maxDepth            int
+	failFast            bool
+	ignoredPaths        [][]string
+	ignoredTypes        []reflect.Type
+	comparers           map[reflect.Type]equalFunc
 }
 
 func newConfig() config {
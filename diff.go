@@ -0,0 +1,198 @@
+package bee
+
+import (
+	"fmt"
+	"strings"
+)
+
+type editOp int
+
+const (
+	editKeep editOp = iota
+	// editDelete drops an element that is only present in expected.
+	editDelete
+	// editInsert adds an element that is only present in actual.
+	editInsert
+)
+
+type edit struct {
+	op       editOp
+	actual   int
+	expected int
+}
+
+// maxDiffCells limits the size of the table used to compute the longest
+// common subsequence, beyond which diff gives up.
+const maxDiffCells = 1 << 22
+
+// diff returns the shortest edit script that turns expected into actual,
+// based on their longest common subsequence. It returns false if the inputs
+// are too large to diff.
+func diff(actualLen, expectedLen int, equal func(i, j int) bool) ([]edit, bool) {
+	prefix := 0
+	for prefix < actualLen && prefix < expectedLen && equal(prefix, prefix) {
+		prefix++
+	}
+	suffix := 0
+	for suffix < actualLen-prefix && suffix < expectedLen-prefix &&
+		equal(actualLen-1-suffix, expectedLen-1-suffix) {
+		suffix++
+	}
+	n := actualLen - prefix - suffix
+	m := expectedLen - prefix - suffix
+	if (n+1)*(m+1) > maxDiffCells {
+		return nil, false
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of
+	// actual[prefix+i:] and expected[prefix+j:].
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if equal(prefix+i, prefix+j) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	edits := make([]edit, 0, actualLen+expectedLen)
+	for k := 0; k < prefix; k++ {
+		edits = append(edits, edit{op: editKeep, actual: k, expected: k})
+	}
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && equal(prefix+i, prefix+j):
+			edits = append(edits, edit{op: editKeep, actual: prefix + i, expected: prefix + j})
+			i++
+			j++
+		case j < m && (i == n || lcs[i][j+1] >= lcs[i+1][j]):
+			edits = append(edits, edit{op: editDelete, actual: prefix + i, expected: prefix + j})
+			j++
+		default:
+			edits = append(edits, edit{op: editInsert, actual: prefix + i, expected: prefix + j})
+			i++
+		}
+	}
+	for k := 0; k < suffix; k++ {
+		edits = append(edits, edit{op: editKeep, actual: prefix + n + k, expected: prefix + m + k})
+	}
+	return edits, true
+}
+
+// hunk is a run of deleted and inserted elements between two kept ones.
+type hunk struct {
+	deleted  []int
+	inserted []int
+}
+
+func hunks(edits []edit) []hunk {
+	var hs []hunk
+	var h hunk
+	for _, e := range edits {
+		switch e.op {
+		case editKeep:
+			if len(h.deleted) > 0 || len(h.inserted) > 0 {
+				hs = append(hs, h)
+				h = hunk{}
+			}
+		case editDelete:
+			h.deleted = append(h.deleted, e.expected)
+		case editInsert:
+			h.inserted = append(h.inserted, e.actual)
+		}
+	}
+	if len(h.deleted) > 0 || len(h.inserted) > 0 {
+		hs = append(hs, h)
+	}
+	return hs
+}
+
+// diffContext is the number of unchanged lines shown around changed ones.
+const diffContext = 3
+
+// unifiedDiff renders the line differences between actual and expected in
+// the unified format, using the expected color for removed lines and the
+// actual color for added lines.
+func (b *Bee) unifiedDiff(actual, expected string) (string, bool) {
+	actualLines := strings.Split(actual, "\n")
+	expectedLines := strings.Split(expected, "\n")
+	edits, ok := diff(len(actualLines), len(expectedLines), func(i, j int) bool {
+		return actualLines[i] == expectedLines[j]
+	})
+	ranges := contextRanges(edits, diffContext)
+	if !ok || len(ranges) == 0 {
+		return "", false
+	}
+	actualStyle := b.cfg.actualTextStyle.UnsetMaxWidth()
+	expectedStyle := b.cfg.expectedTextStyle.UnsetMaxWidth()
+	whatStyle := b.cfg.whatTextStyle
+	lines := []string{
+		expectedStyle.Render("--- expected"),
+		actualStyle.Render("+++ actual"),
+	}
+	for _, r := range ranges {
+		group := edits[r[0]:r[1]]
+		expectedCount, actualCount := 0, 0
+		for _, e := range group {
+			if e.op != editInsert {
+				expectedCount++
+			}
+			if e.op != editDelete {
+				actualCount++
+			}
+		}
+		lines = append(lines, whatStyle.Render(fmt.Sprintf(
+			"@@ -%s +%s @@",
+			hunkRange(group[0].expected, expectedCount),
+			hunkRange(group[0].actual, actualCount),
+		)))
+		for _, e := range group {
+			switch e.op {
+			case editKeep:
+				lines = append(lines, " "+actualLines[e.actual])
+			case editDelete:
+				lines = append(lines, expectedStyle.Render("-"+expectedLines[e.expected]))
+			case editInsert:
+				lines = append(lines, actualStyle.Render("+"+actualLines[e.actual]))
+			}
+		}
+	}
+	return strings.Join(lines, "\n"), true
+}
+
+// contextRanges returns the [start, end) ranges of edits that contain
+// changes, extended by context unchanged edits on both sides and merged
+// when they overlap.
+func contextRanges(edits []edit, context int) [][2]int {
+	var ranges [][2]int
+	for i, e := range edits {
+		if e.op == editKeep {
+			continue
+		}
+		start, end := max(0, i-context), min(len(edits), i+context+1)
+		if n := len(ranges); n > 0 && start <= ranges[n-1][1] {
+			ranges[n-1][1] = end
+			continue
+		}
+		ranges = append(ranges, [2]int{start, end})
+	}
+	return ranges
+}
+
+// hunkRange formats the 1-based line range of a hunk. An empty range starts
+// at the line before it, as in the unified format.
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
@@ -0,0 +1,104 @@
+package bee
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+func (b *Bee) ErrorIs(err, target error, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	ok := errors.Is(err, target)
+	if !ok {
+		b.errorChain(err, target, "is not")
+	}
+	return b.done(ok)
+}
+
+// ErrorAs finds the first error in the chain of err that matches T, and
+// returns it together with whether it was found.
+func ErrorAs[T error](b *Bee, err error, msgAndArgs ...any) (T, bool) {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	var target T
+	ok := errors.As(err, &target)
+	if !ok {
+		b.errorChain(err, reflect.TypeFor[T](), "is not a")
+	}
+	return target, b.done(ok)
+}
+
+func (b *Bee) ErrorContains(err error, substr string, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	ok := err != nil && strings.Contains(err.Error(), substr)
+	if !ok {
+		b.errorChain(err, substr, "does not contain")
+	}
+	return b.done(ok)
+}
+
+// errorChain reports err and logs its whole chain of wrapped errors.
+func (b *Bee) errorChain(err error, expected any, relation string) {
+	b.tb.Helper()
+	b.errorLine(err, expected, "", relation)
+	if err != nil {
+		b.tb.Logf("\n%s", b.formatChain(err))
+	}
+}
+
+// maxChainDepth limits how deep formatChain follows wrapped errors.
+const maxChainDepth = 64
+
+// formatChain renders err as a tree of the errors it wraps, either through
+// Unwrap() error or Unwrap() []error, with the concrete type of each error.
+func (b *Bee) formatChain(err error) string {
+	lines := []string{b.formatLink(err)}
+	var walk func(err error, indent string, depth int)
+	walk = func(err error, indent string, depth int) {
+		children := unwrap(err)
+		if len(children) > 0 && depth >= maxChainDepth {
+			lines = append(lines, indent+"└── ...")
+			return
+		}
+		for i, child := range children {
+			connector, next := "├── ", "│   "
+			if i == len(children)-1 {
+				connector, next = "└── ", "    "
+			}
+			lines = append(lines, indent+connector+b.formatLink(child))
+			walk(child, indent+next, depth+1)
+		}
+	}
+	walk(err, "", 0)
+	return strings.Join(lines, "\n")
+}
+
+func (b *Bee) formatLink(err error) string {
+	return fmt.Sprintf(
+		"%s %s",
+		b.cfg.whatTextStyle.Render(fmt.Sprintf("%T", err)),
+		b.cfg.actualTextStyle.UnsetMaxWidth().Render(strconv.Quote(err.Error())),
+	)
+}
+
+func unwrap(err error) []error {
+	switch x := err.(type) {
+	case interface{ Unwrap() error }:
+		if inner := x.Unwrap(); inner != nil {
+			return []error{inner}
+		}
+	case interface{ Unwrap() []error }:
+		var inner []error
+		for _, e := range x.Unwrap() {
+			if e != nil {
+				inner = append(inner, e)
+			}
+		}
+		return inner
+	}
+	return nil
+}
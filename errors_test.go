@@ -0,0 +1,171 @@
+package bee_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/danielrenes/bee"
+)
+
+var errNotFound = errors.New("not found")
+
+type codeError struct {
+	code int
+}
+
+func (e *codeError) Error() string {
+	return fmt.Sprintf("code %d", e.code)
+}
+
+func TestErrorIs(t *testing.T) {
+	wrapped := fmt.Errorf("load config: %w", &fs.PathError{Op: "open", Path: "x", Err: errNotFound})
+	joined := errors.Join(errors.New("a"), fmt.Errorf("b: %w", &codeError{code: 1}))
+
+	tests := []struct {
+		err     error
+		target  error
+		wantErr bool
+		errMsg  string
+		logMsg  string
+	}{
+		{
+			err:     wrapped,
+			target:  errNotFound,
+			wantErr: false,
+			errMsg:  "",
+			logMsg:  "",
+		},
+		{
+			err:     wrapped,
+			target:  fs.ErrPermission,
+			wantErr: true,
+			errMsg:  "load config: open x: not found is not permission denied",
+			logMsg: `
+*fmt.wrapError "load config: open x: not found"
+└── *fs.PathError "open x: not found"
+    └── *errors.errorString "not found"`,
+		},
+		{
+			err:     joined,
+			target:  errNotFound,
+			wantErr: true,
+			errMsg:  "ab: code 1 is not not found",
+			logMsg: `
+*errors.joinError "a\nb: code 1"
+├── *errors.errorString "a"
+└── *fmt.wrapError "b: code 1"
+    └── *bee_test.codeError "code 1"`,
+		},
+		{
+			err:     nil,
+			target:  errNotFound,
+			wantErr: true,
+			errMsg:  "<nil> is not not found",
+			logMsg:  "",
+		},
+	}
+
+	for _, test := range tests {
+		mockT := &mockT{T: t}
+		bee := bee.New(mockT, bee.NoColor())
+		bee.ErrorIs(test.err, test.target)
+		if mockT.wasErr != test.wantErr {
+			if test.wantErr {
+				t.Error("expected error")
+			} else {
+				t.Error("expected no error")
+			}
+		}
+		if mockT.errMsg != test.errMsg {
+			t.Errorf("%q != %q", mockT.errMsg, test.errMsg)
+		}
+		if mockT.logMsg != test.logMsg {
+			t.Errorf("%q != %q", mockT.logMsg, test.logMsg)
+		}
+	}
+}
+
+func TestErrorAs(t *testing.T) {
+	mt := &mockT{T: t}
+	b := bee.New(mt, bee.NoColor())
+	err := fmt.Errorf("request: %w", &codeError{code: 404})
+	target, ok := bee.ErrorAs[*codeError](b, err)
+	if !ok || mt.wasErr {
+		t.Errorf("unexpected error: %q", mt.errMsg)
+	}
+	if target == nil || target.code != 404 {
+		t.Errorf("%v != %v", target, &codeError{code: 404})
+	}
+
+	_, ok = bee.ErrorAs[*fs.PathError](b, err)
+	if ok {
+		t.Error("expected not ok")
+	}
+	errMsg := "request: code 404 is not a *fs.PathError"
+	if mt.errMsg != errMsg {
+		t.Errorf("%q != %q", mt.errMsg, errMsg)
+	}
+	logMsg := `
+*fmt.wrapError "request: code 404"
+└── *bee_test.codeError "code 404"`
+	if mt.logMsg != logMsg {
+		t.Errorf("%q != %q", mt.logMsg, logMsg)
+	}
+}
+
+func TestErrorContains(t *testing.T) {
+	tests := []struct {
+		err     error
+		substr  string
+		wantErr bool
+		errMsg  string
+		newBee  newBee
+	}{
+		{
+			err:     errors.New("open x: permission denied"),
+			substr:  "permission",
+			wantErr: false,
+			errMsg:  "",
+			newBee:  newBeeWithoutColor(),
+		},
+		{
+			err:     errors.New("open x: not found"),
+			substr:  "permission",
+			wantErr: true,
+			errMsg:  "open x: not found does not contain permission",
+			newBee:  newBeeWithoutColor(),
+		},
+		{
+			err:     nil,
+			substr:  "permission",
+			wantErr: true,
+			errMsg:  "<nil> does not contain permission",
+			newBee:  newBeeWithoutColor(),
+		},
+		{
+			err:     errors.New("open x: not found"),
+			substr:  "permission",
+			wantErr: true,
+			errMsg:  "\x1b[38;2;1;1;1mopen x: not found\x1b[0m does not contain \x1b[38;2;2;2;2mpermission\x1b[0m",
+			newBee:  newBeeWithCustomColor(),
+		},
+	}
+
+	for _, test := range tests {
+		mockT := &mockT{T: t}
+		bee := test.newBee(mockT)
+		bee.ErrorContains(test.err, test.substr)
+		if mockT.wasErr != test.wantErr {
+			if test.wantErr {
+				t.Error("expected error")
+			} else {
+				t.Error("expected no error")
+			}
+		}
+		if mockT.errMsg != test.errMsg {
+			t.Errorf("%q != %q", mockT.errMsg, test.errMsg)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package bee
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Eventually checks that condition returns true within timeout, calling it
+// every interval.
+func (b *Bee) Eventually(condition func() bool, timeout, interval time.Duration, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	ok := poll(timeout, interval, condition)
+	if !ok {
+		b.errorLine(false, true, fmt.Sprintf("condition after %s", timeout), "!=")
+	}
+	return b.done(ok)
+}
+
+// Consistently checks that condition keeps returning true for duration,
+// calling it every interval.
+func (b *Bee) Consistently(condition func() bool, duration, interval time.Duration, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	failed := poll(duration, interval, func() bool { return !condition() })
+	if failed {
+		b.errorLine(false, true, fmt.Sprintf("condition within %s", duration), "!=")
+	}
+	return b.done(!failed)
+}
+
+// EventuallyWith calls fn every interval until none of the assertions made
+// through the Bee passed to fn fails. The failures of the attempts are not
+// reported, except for those of the last attempt if timeout is reached.
+func (b *Bee) EventuallyWith(fn func(b *Bee), timeout, interval time.Duration, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	var last *collector
+	attempts := 0
+	ok := poll(timeout, interval, func() bool {
+		attempts++
+		last = b.attempt(fn)
+		return !last.Failed()
+	})
+	if !ok {
+		last.replay(b.tb)
+		b.annotate(msgAndArgs).errorf("", "%d attempts failed within %s", attempts, timeout)
+	}
+	return b.done(ok)
+}
+
+// poll calls attempt every interval until it returns true or timeout is
+// reached, and reports whether it returned true.
+func poll(timeout, interval time.Duration, attempt func() bool) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		if attempt() {
+			return true
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return false
+		}
+		time.Sleep(min(interval, remaining))
+	}
+}
+
+// attempt calls fn with a Bee that collects its failures instead of
+// reporting them. fn runs on its own goroutine so that FailNow can stop it,
+// and a panic in fn is collected as a failure of the attempt.
+func (b *Bee) attempt(fn func(b *Bee)) *collector {
+	col := &collector{TB: b.tb}
+	c := *b
+	c.tb = col
+	c.cfg.failFast = false
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		recovered, panicked, stack := catch(func() { fn(&c) })
+		if panicked {
+			c.errorLine(c.formatPanic(recovered), noPanic, "", "!=")
+			c.logColumns(c.formatPanic(recovered)+"\n\n"+stack, noPanic)
+		}
+	}()
+	<-done
+	return col
+}
+
+// collector is a testing.TB that records the failures and logs of an
+// attempt, so that they can be replayed on the parent if needed.
+type collector struct {
+	testing.TB
+	mu      sync.Mutex
+	failed  bool
+	entries []entry
+}
+
+type entry struct {
+	err bool
+	msg string
+}
+
+func (c *collector) record(err bool, msg string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failed = c.failed || err
+	c.entries = append(c.entries, entry{err: err, msg: msg})
+}
+
+func (c *collector) Error(args ...any) {
+	c.record(true, fmt.Sprintln(args...))
+}
+
+func (c *collector) Errorf(format string, args ...any) {
+	c.record(true, fmt.Sprintf(format, args...))
+}
+
+func (c *collector) Log(args ...any) {
+	c.record(false, fmt.Sprintln(args...))
+}
+
+func (c *collector) Logf(format string, args ...any) {
+	c.record(false, fmt.Sprintf(format, args...))
+}
+
+func (c *collector) Fail() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failed = true
+}
+
+func (c *collector) FailNow() {
+	c.Fail()
+	runtime.Goexit()
+}
+
+func (c *collector) Failed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.failed
+}
+
+func (c *collector) Fatal(args ...any) {
+	c.Error(args...)
+	runtime.Goexit()
+}
+
+func (c *collector) Fatalf(format string, args ...any) {
+	c.Errorf(format, args...)
+	runtime.Goexit()
+}
+
+func (c *collector) replay(tb testing.TB) {
+	tb.Helper()
+	for _, e := range c.entries {
+		if e.err {
+			tb.Errorf("%s", e.msg)
+		} else {
+			tb.Logf("%s", e.msg)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package bee_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/danielrenes/bee"
+)
+
+const (
+	timeout  = 50 * time.Millisecond
+	interval = 5 * time.Millisecond
+)
+
+func after(n int) func() bool {
+	calls := 0
+	return func() bool {
+		calls++
+		return calls >= n
+	}
+}
+
+func until(n int) func() bool {
+	calls := 0
+	return func() bool {
+		calls++
+		return calls < n
+	}
+}
+
+func TestEventually(t *testing.T) {
+	tests := []struct {
+		assert  func(b *bee.Bee) bool
+		wantErr bool
+		errMsgs []string
+	}{
+		{
+			assert:  func(b *bee.Bee) bool { return b.Eventually(after(3), timeout, interval) },
+			wantErr: false,
+			errMsgs: nil,
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Eventually(after(1000), timeout, interval) },
+			wantErr: true,
+			errMsgs: []string{"false != true \\(condition after 50ms\\)"},
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Eventually(after(1000), timeout, interval, "server ready") },
+			wantErr: true,
+			errMsgs: []string{"false != true \\(condition after 50ms\\): server ready"},
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Consistently(until(1000), timeout, interval) },
+			wantErr: false,
+			errMsgs: nil,
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Consistently(until(3), timeout, interval) },
+			wantErr: true,
+			errMsgs: []string{"false != true \\(condition within 50ms\\)"},
+		},
+		{
+			assert: func(b *bee.Bee) bool {
+				n := 0
+				return b.EventuallyWith(func(b *bee.Bee) {
+					n++
+					b.Equal(n, 3)
+					b.True(n >= 3)
+				}, timeout, interval)
+			},
+			wantErr: false,
+			errMsgs: nil,
+		},
+		{
+			assert: func(b *bee.Bee) bool {
+				return b.EventuallyWith(func(b *bee.Bee) {
+					b.Equal("pending", "done")
+				}, timeout, interval, "job %d", 7)
+			},
+			wantErr: true,
+			errMsgs: []string{"pending != done", "\\d+ attempts failed within 50ms: job 7"},
+		},
+		{
+			assert: func(b *bee.Bee) bool {
+				return b.With("id", 7).EventuallyWith(func(b *bee.Bee) {
+					b.Require().Equal(1, 2)
+					b.Equal(3, 4)
+				}, timeout, interval)
+			},
+			wantErr: true,
+			errMsgs: []string{"1 != 2 \\[id=7\\]", "\\d+ attempts failed within 50ms \\[id=7\\]"},
+		},
+		{
+			assert: func(b *bee.Bee) bool {
+				var resp *response
+				n := 0
+				return b.EventuallyWith(func(b *bee.Bee) {
+					if n++; n == 3 {
+						resp = &response{Status: 200}
+					}
+					b.Equal(resp.Status, 200)
+				}, timeout, interval)
+			},
+			wantErr: false,
+			errMsgs: nil,
+		},
+		{
+			assert: func(b *bee.Bee) bool {
+				var resp *response
+				return b.EventuallyWith(func(b *bee.Bee) {
+					b.Equal(resp.Status, 200)
+				}, timeout, interval)
+			},
+			wantErr: true,
+			errMsgs: []string{
+				"panic\\(invalid memory address or nil pointer dereference\\) != <no panic>",
+				"\\d+ attempts failed within 50ms",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		mt := &mockT{T: t}
+		ok := test.assert(bee.New(mt, bee.NoColor()))
+		if ok == test.wantErr {
+			t.Errorf("%v == %v", ok, test.wantErr)
+		}
+		if mt.wasErr != test.wantErr {
+			if test.wantErr {
+				t.Error("expected error")
+			} else {
+				t.Error("expected no error")
+			}
+		}
+		if len(mt.errMsgs) != len(test.errMsgs) {
+			t.Fatalf("%q != %q", mt.errMsgs, test.errMsgs)
+		}
+		for i, errMsg := range mt.errMsgs {
+			if !regex("^" + test.errMsgs[i] + "$")(errMsg) {
+				t.Errorf("%q does not match %q", errMsg, test.errMsgs[i])
+			}
+		}
+	}
+}
+
+func TestEventuallyRequire(t *testing.T) {
+	mt := &mockT{T: t}
+	bee.New(mt, bee.NoColor()).Require().EventuallyWith(func(b *bee.Bee) {
+		b.Equal(1, 2)
+		b.Equal(3, 4)
+	}, timeout, interval)
+	if !mt.wasFail {
+		t.Error("expected FailNow")
+	}
+	if len(mt.errMsgs) != 3 {
+		t.Errorf("%q does not contain every failure of the last attempt", mt.errMsgs)
+	}
+}
+
+func TestEventuallyPanic(t *testing.T) {
+	mt := &mockT{T: t}
+	var resp *response
+	bee.New(mt, bee.NoColor(), bee.ColumnWidth(80)).EventuallyWith(func(b *bee.Bee) {
+		b.Equal(resp.Status, 200)
+	}, timeout, interval)
+	if !mt.wasLog {
+		t.Fatal("expected log")
+	}
+	if !strings.Contains(mt.logMsg, "bee_test.TestEventuallyPanic") {
+		t.Errorf("%q does not contain the panicking function", mt.logMsg)
+	}
+}
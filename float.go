@@ -0,0 +1,68 @@
+package bee
+
+import "math"
+
+// tolerance is the allowed difference between two floats. Only one of the
+// modes is in use at a time: ulps, then rel, then abs take precedence.
+type tolerance struct {
+	abs  float64
+	rel  float64
+	ulps uint64
+}
+
+// floatEqual reports whether a and b, floats of the given bit size, are equal
+// within the tolerance. NaN is only equal to NaN when nanEqual is set, and
+// infinities are only equal to infinities of the same sign.
+func floatEqual(a, b float64, bits int, tol tolerance, nanEqual bool) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return nanEqual && math.IsNaN(a) && math.IsNaN(b)
+	}
+	if math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return a == b
+	}
+	switch {
+	case tol.ulps > 0:
+		return ulpDistance(a, b, bits) <= tol.ulps
+	case tol.rel > 0:
+		return math.Abs(a-b) <= tol.rel*math.Max(math.Abs(a), math.Abs(b))
+	}
+	return math.Abs(a-b) <= tol.abs
+}
+
+func complexEqual(a, b complex128, bits int, tol tolerance, nanEqual bool) bool {
+	return floatEqual(real(a), real(b), bits/2, tol, nanEqual) &&
+		floatEqual(imag(a), imag(b), bits/2, tol, nanEqual)
+}
+
+// ulpDistance returns the number of representable floats of the given bit
+// size between a and b.
+func ulpDistance(a, b float64, bits int) uint64 {
+	var x, y int64
+	if bits == 32 {
+		x, y = ordered32(float32(a)), ordered32(float32(b))
+	} else {
+		x, y = ordered64(a), ordered64(b)
+	}
+	if x < y {
+		x, y = y, x
+	}
+	return uint64(x) - uint64(y)
+}
+
+// ordered32 and ordered64 map floats to integers that sort the same way,
+// so that adjacent floats map to adjacent integers.
+func ordered32(f float32) int64 {
+	i := int32(math.Float32bits(f))
+	if i < 0 {
+		i = math.MinInt32 - i
+	}
+	return int64(i)
+}
+
+func ordered64(f float64) int64 {
+	i := int64(math.Float64bits(f))
+	if i < 0 {
+		i = math.MinInt64 - i
+	}
+	return i
+}
@@ -0,0 +1,78 @@
+package bee
+
+import "cmp"
+
+// Eq is the type-safe variant of Equal: actual and expected must have the
+// same type, which also decides the type of untyped constants.
+func Eq[T any](b *Bee, actual, expected T, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	return b.Equal(actual, expected, msgAndArgs...)
+}
+
+// Ne is the type-safe variant of NotEqual.
+func Ne[T any](b *Bee, actual, expected T, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	return b.NotEqual(actual, expected, msgAndArgs...)
+}
+
+func Lt[T cmp.Ordered](b *Bee, actual, expected T, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	if isNaN(actual) || isNaN(expected) {
+		b.errorNaN()
+		return b.done(false)
+	}
+	ok := actual < expected
+	if !ok {
+		b.error(actual, expected, "", ">=")
+	}
+	return b.done(ok)
+}
+
+func Le[T cmp.Ordered](b *Bee, actual, expected T, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	if isNaN(actual) || isNaN(expected) {
+		b.errorNaN()
+		return b.done(false)
+	}
+	ok := actual <= expected
+	if !ok {
+		b.error(actual, expected, "", ">")
+	}
+	return b.done(ok)
+}
+
+func Gt[T cmp.Ordered](b *Bee, actual, expected T, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	if isNaN(actual) || isNaN(expected) {
+		b.errorNaN()
+		return b.done(false)
+	}
+	ok := actual > expected
+	if !ok {
+		b.error(actual, expected, "", "<=")
+	}
+	return b.done(ok)
+}
+
+func Ge[T cmp.Ordered](b *Bee, actual, expected T, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	if isNaN(actual) || isNaN(expected) {
+		b.errorNaN()
+		return b.done(false)
+	}
+	ok := actual >= expected
+	if !ok {
+		b.error(actual, expected, "", "<")
+	}
+	return b.done(ok)
+}
+
+// isNaN reports whether v is a floating-point NaN, the only value that is not
+// equal to itself.
+func isNaN[T cmp.Ordered](v T) bool {
+	return v != v
+}
@@ -0,0 +1,108 @@
+package bee_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/danielrenes/bee"
+)
+
+func TestEq(t *testing.T) {
+	mt := &mockT{T: t}
+	b := bee.New(mt, bee.NoColor())
+	var n int64 = 1
+	bee.Eq(b, n, 1)
+	if mt.wasErr {
+		t.Errorf("unexpected error: %q", mt.errMsg)
+	}
+	bee.Eq(b, []time.Duration{time.Second}, []time.Duration{2 * time.Second})
+	if mt.errMsg != "1000000000 != 2000000000 ([0])" {
+		t.Errorf("%q != %q", mt.errMsg, "1000000000 != 2000000000 ([0])")
+	}
+
+	mt = &mockT{T: t}
+	b = bee.New(mt, bee.NoColor())
+	bee.Ne(b, "a", "b")
+	if mt.wasErr {
+		t.Errorf("unexpected error: %q", mt.errMsg)
+	}
+	bee.Ne(b, "a", "a")
+	if mt.errMsg != "a == a" {
+		t.Errorf("%q != %q", mt.errMsg, "a == a")
+	}
+}
+
+func TestOrdered(t *testing.T) {
+	tests := []struct {
+		assert  func(b *bee.Bee)
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			assert:  func(b *bee.Bee) { bee.Lt(b, 1, 2) },
+			wantErr: false,
+			errMsg:  "",
+		},
+		{
+			assert:  func(b *bee.Bee) { bee.Lt(b, 2, 2) },
+			wantErr: true,
+			errMsg:  "2 >= 2",
+		},
+		{
+			assert:  func(b *bee.Bee) { bee.Le(b, 2, 2) },
+			wantErr: false,
+			errMsg:  "",
+		},
+		{
+			assert:  func(b *bee.Bee) { bee.Le(b, 3, 2) },
+			wantErr: true,
+			errMsg:  "3 > 2",
+		},
+		{
+			assert:  func(b *bee.Bee) { bee.Gt(b, "b", "a") },
+			wantErr: false,
+			errMsg:  "",
+		},
+		{
+			assert:  func(b *bee.Bee) { bee.Gt(b, "a", "b") },
+			wantErr: true,
+			errMsg:  "a <= b",
+		},
+		{
+			assert:  func(b *bee.Bee) { bee.Ge(b, 1.5, 1.5) },
+			wantErr: false,
+			errMsg:  "",
+		},
+		{
+			assert:  func(b *bee.Bee) { bee.Ge(b, math.NaN(), 1) },
+			wantErr: true,
+			errMsg:  "NaN cannot be ordered",
+		},
+		{
+			assert:  func(b *bee.Bee) { bee.Lt(b, 1, math.NaN()) },
+			wantErr: true,
+			errMsg:  "NaN cannot be ordered",
+		},
+		{
+			assert:  func(b *bee.Bee) { bee.Lt(b, time.Second, time.Millisecond) },
+			wantErr: true,
+			errMsg:  "1s >= 1ms",
+		},
+	}
+
+	for _, test := range tests {
+		mockT := &mockT{T: t}
+		test.assert(bee.New(mockT, bee.NoColor()))
+		if mockT.wasErr != test.wantErr {
+			if test.wantErr {
+				t.Error("expected error")
+			} else {
+				t.Error("expected no error")
+			}
+		}
+		if mockT.errMsg != test.errMsg {
+			t.Errorf("%q != %q", mockT.errMsg, test.errMsg)
+		}
+	}
+}
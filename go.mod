@@ -5,6 +5,7 @@ go 1.23.6
 require (
 	github.com/charmbracelet/lipgloss v1.0.0
 	github.com/muesli/termenv v0.15.2
+	github.com/rivo/uniseg v0.4.7
 )
 
 require (
@@ -13,6 +14,5 @@ require (
 	github.com/lucasb-eyer/go-colorful v1.2.0 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
 	github.com/mattn/go-runewidth v0.0.15 // indirect
-	github.com/rivo/uniseg v0.4.7 // indirect
 	golang.org/x/sys v0.19.0 // indirect
 )
@@ -0,0 +1,135 @@
+package bee
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/rivo/uniseg"
+)
+
+// highlight renders two different single-line strings with the characters
+// that differ between them highlighted. Strings wider than the column width
+// are truncated around the first difference, so that it stays visible. It
+// returns false if the strings have nothing in common to highlight against.
+func (b *Bee) highlight(actual, expected string) (string, string, bool) {
+	actualClusters := graphemes(actual)
+	expectedClusters := graphemes(expected)
+	prefix := 0
+	for prefix < len(actualClusters) && prefix < len(expectedClusters) &&
+		actualClusters[prefix] == expectedClusters[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(actualClusters)-prefix && suffix < len(expectedClusters)-prefix &&
+		actualClusters[len(actualClusters)-1-suffix] == expectedClusters[len(expectedClusters)-1-suffix] {
+		suffix++
+	}
+	if prefix == 0 && suffix == 0 {
+		return "", "", false
+	}
+	renderedActual := b.renderHighlighted(actualClusters, prefix, len(actualClusters)-suffix, b.cfg.actualTextStyle)
+	renderedExpected := b.renderHighlighted(expectedClusters, prefix, len(expectedClusters)-suffix, b.cfg.expectedTextStyle)
+	return renderedActual, renderedExpected, true
+}
+
+// renderHighlighted renders clusters with the [from, to) range highlighted,
+// truncated to the max width of style around from.
+func (b *Bee) renderHighlighted(clusters []grapheme, from, to int, style lipgloss.Style) string {
+	start, end := window(clusters, from, style.GetMaxWidth())
+	style = style.UnsetMaxWidth()
+	from, to = min(max(from, start), end), min(max(to, start), end)
+	s := render(style, clusters[start:from]) +
+		render(style.Inherit(b.cfg.highlightStyle), clusters[from:to]) +
+		render(style, clusters[to:end])
+	if start > 0 {
+		s = fmt.Sprintf("%s%s", style.Render("..."), s)
+	}
+	if end < len(clusters) {
+		s = fmt.Sprintf("%s%s", s, style.Render("..."))
+	}
+	return s
+}
+
+func render(style lipgloss.Style, clusters []grapheme) string {
+	if len(clusters) == 0 {
+		return ""
+	}
+	return style.Render(join(clusters))
+}
+
+// window returns the [start, end) range of clusters that fits into w columns
+// together with the "..." markers of the truncated ends, such that the
+// cluster at index d and a few clusters around it are visible.
+func window(clusters []grapheme, d, w int) (int, int) {
+	n := len(clusters)
+	if width(clusters) <= w {
+		return 0, n
+	}
+	if end := fit(clusters, 0, w-3); end > d+min(8, w/4) {
+		return 0, end
+	}
+	start := fitBack(clusters, d, w/3)
+	end := fit(clusters, start, w-6)
+	if end >= n {
+		return fitBack(clusters, n, w-3), n
+	}
+	return start, end
+}
+
+// grapheme is a user-perceived character together with the number of
+// columns it occupies in a terminal.
+type grapheme struct {
+	s     string
+	width int
+}
+
+func graphemes(s string) []grapheme {
+	var clusters []grapheme
+	state := -1
+	for s != "" {
+		var cluster string
+		var w int
+		cluster, s, w, state = uniseg.FirstGraphemeClusterInString(s, state)
+		clusters = append(clusters, grapheme{s: cluster, width: w})
+	}
+	return clusters
+}
+
+func join(clusters []grapheme) string {
+	var sb strings.Builder
+	for _, c := range clusters {
+		sb.WriteString(c.s)
+	}
+	return sb.String()
+}
+
+func width(clusters []grapheme) int {
+	w := 0
+	for _, c := range clusters {
+		w += c.width
+	}
+	return w
+}
+
+// fit returns the end of the longest run of clusters from start that fits
+// into w columns.
+func fit(clusters []grapheme, start, w int) int {
+	end := start
+	for end < len(clusters) && clusters[end].width <= w {
+		w -= clusters[end].width
+		end++
+	}
+	return end
+}
+
+// fitBack returns the start of the longest run of clusters up to end that
+// fits into w columns.
+func fitBack(clusters []grapheme, end, w int) int {
+	start := end
+	for start > 0 && clusters[start-1].width <= w {
+		w -= clusters[start-1].width
+		start--
+	}
+	return start
+}
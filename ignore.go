@@ -0,0 +1,49 @@
+package bee
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// ignoredType reports whether the values of type t are skipped.
+func (cfg *config) ignoredType(t reflect.Type) bool {
+	return slices.Contains(cfg.ignoredTypes, t)
+}
+
+// ignoredPath reports whether the values at what are skipped.
+func (cfg *config) ignoredPath(what string) bool {
+	if len(cfg.ignoredPaths) == 0 {
+		return false
+	}
+	path := segments(what)
+	return slices.ContainsFunc(cfg.ignoredPaths, func(pattern []string) bool {
+		return slices.EqualFunc(path, pattern, func(s, p string) bool {
+			return s == p || p == "[*]" && strings.HasPrefix(s, "[")
+		})
+	})
+}
+
+// segments splits a path into its fields and indexes, such as ".Items",
+// "[0]" and ".ID". The leading dereferences of pointers are dropped, so that
+// a path matches regardless of the pointers along the way.
+func segments(path string) []string {
+	path = strings.TrimLeft(path, "*")
+	if path != "" && path[0] != '.' && path[0] != '[' {
+		path = "." + path
+	}
+	var segs []string
+	for path != "" {
+		end := len(path)
+		if path[0] == '[' {
+			if i := strings.IndexByte(path, ']'); i >= 0 {
+				end = i + 1
+			}
+		} else if i := strings.IndexAny(path[1:], ".["); i >= 0 {
+			end = i + 1
+		}
+		segs = append(segs, path[:end])
+		path = path[end:]
+	}
+	return segs
+}
@@ -1,6 +1,12 @@
 package bee
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"maps"
+	"reflect"
+	"slices"
+
+	"github.com/charmbracelet/lipgloss"
+)
 
 type option func(cfg *config)
 
@@ -16,10 +22,12 @@ func ColumnWidth(w int) option {
 func NoColor() option {
 	return func(cfg *config) {
 		cfg.whatTextStyle = cfg.whatTextStyle.Foreground(lipgloss.NoColor{})
+		cfg.messageTextStyle = cfg.messageTextStyle.Foreground(lipgloss.NoColor{})
 		cfg.expectedTextStyle = cfg.expectedTextStyle.Foreground(lipgloss.NoColor{})
 		cfg.actualTextStyle = cfg.actualTextStyle.Foreground(lipgloss.NoColor{})
 		cfg.expectedColumnStyle = cfg.expectedColumnStyle.Foreground(lipgloss.NoColor{})
 		cfg.actualColumnStyle = cfg.actualColumnStyle.Foreground(lipgloss.NoColor{})
+		cfg.highlightStyle = cfg.highlightStyle.Background(lipgloss.NoColor{})
 	}
 }
 
@@ -29,6 +37,12 @@ func WhatColor(r, g, b uint8) option {
 	}
 }
 
+func MessageColor(r, g, b uint8) option {
+	return func(cfg *config) {
+		cfg.messageTextStyle = cfg.messageTextStyle.Foreground(rgb(r, g, b))
+	}
+}
+
 func ExpectedColor(r, g, b uint8) option {
 	return func(cfg *config) {
 		cfg.expectedTextStyle = cfg.expectedTextStyle.Foreground(rgb(r, g, b))
@@ -42,3 +56,92 @@ func ActualColor(r, g, b uint8) option {
 		cfg.actualColumnStyle = cfg.actualColumnStyle.Foreground(rgb(r, g, b))
 	}
 }
+
+func HighlightColor(r, g, b uint8) option {
+	return func(cfg *config) {
+		cfg.highlightStyle = cfg.highlightStyle.Background(rgb(r, g, b))
+	}
+}
+
+func MaxDepth(depth int) option {
+	return func(cfg *config) {
+		cfg.maxDepth = depth
+	}
+}
+
+func FailFast() option {
+	return func(cfg *config) {
+		cfg.failFast = true
+	}
+}
+
+// DrainChannels compares the pending elements of buffered channels instead of
+// their identity. The elements are received and sent back, so only channels
+// that can be both received from and sent to are drained, others are still
+// compared by identity. Closed channels cannot be refilled and are left empty.
+func DrainChannels() option {
+	return func(cfg *config) {
+		cfg.drainChannels = true
+	}
+}
+
+func NaNEqual() option {
+	return func(cfg *config) {
+		cfg.nanEqual = true
+	}
+}
+
+func FloatAbsTolerance(eps float64) option {
+	return func(cfg *config) {
+		cfg.tolerance = tolerance{abs: eps}
+	}
+}
+
+func FloatRelTolerance(rel float64) option {
+	return func(cfg *config) {
+		cfg.tolerance = tolerance{rel: rel}
+	}
+}
+
+func FloatULP(n uint64) option {
+	return func(cfg *config) {
+		cfg.tolerance = tolerance{ulps: n}
+	}
+}
+
+// IgnorePaths skips the values at paths when comparing, written in the
+// notation of the failures, such as ".Meta" or ".Items[*].ID" where [*]
+// matches any index or key.
+func IgnorePaths(paths ...string) option {
+	return func(cfg *config) {
+		for _, path := range paths {
+			cfg.ignoredPaths = append(slices.Clip(cfg.ignoredPaths), segments(path))
+		}
+	}
+}
+
+// IgnoreType skips the values of type T when comparing.
+func IgnoreType[T any]() option {
+	return func(cfg *config) {
+		cfg.ignoredTypes = append(slices.Clip(cfg.ignoredTypes), reflect.TypeFor[T]())
+	}
+}
+
+// Comparer compares the values of type T with equal, instead of by their
+// fields or elements. Types with an Equal(T) bool method are compared with
+// it by default. Values reached through unexported fields are compared by
+// their fields or elements regardless.
+func Comparer[T any](equal func(a, b T) bool) option {
+	return func(cfg *config) {
+		comparers := maps.Clone(cfg.comparers)
+		if comparers == nil {
+			comparers = map[reflect.Type]equalFunc{}
+		}
+		comparers[reflect.TypeFor[T]()] = func(a, b any) bool {
+			x, _ := a.(T)
+			y, _ := b.(T)
+			return equal(x, y)
+		}
+		cfg.comparers = comparers
+	}
+}
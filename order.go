@@ -0,0 +1,124 @@
+package bee
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+func (b *Bee) Greater(actual, expected any, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	return b.annotate(msgAndArgs).order(actual, expected, "<=", func(c int) bool { return c > 0 })
+}
+
+func (b *Bee) GreaterOrEqual(actual, expected any, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	return b.annotate(msgAndArgs).order(actual, expected, "<", func(c int) bool { return c >= 0 })
+}
+
+func (b *Bee) Less(actual, expected any, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	return b.annotate(msgAndArgs).order(actual, expected, ">=", func(c int) bool { return c < 0 })
+}
+
+func (b *Bee) LessOrEqual(actual, expected any, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	return b.annotate(msgAndArgs).order(actual, expected, ">", func(c int) bool { return c <= 0 })
+}
+
+// Positive checks that actual is greater than the zero value of its type.
+func (b *Bee) Positive(actual any, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	return b.annotate(msgAndArgs).order(actual, zero(actual), "<=", func(c int) bool { return c > 0 })
+}
+
+// Negative checks that actual is less than the zero value of its type.
+func (b *Bee) Negative(actual any, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	return b.annotate(msgAndArgs).order(actual, zero(actual), ">=", func(c int) bool { return c < 0 })
+}
+
+// InRange checks that lo <= actual <= hi.
+func (b *Bee) InRange(actual, lo, hi any, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	cmpLo, okLo := b.ordered(actual, lo)
+	cmpHi, okHi := b.ordered(actual, hi)
+	if !okLo || !okHi {
+		return b.done(false)
+	}
+	ok := cmpLo >= 0 && cmpHi <= 0
+	if !ok {
+		b.error(actual, fmt.Sprintf("[%s, %s]", b.format(lo, false), b.format(hi, false)), "", "∉")
+	}
+	return b.done(ok)
+}
+
+func (b *Bee) order(actual, expected any, relation string, accept func(int) bool) bool {
+	b.tb.Helper()
+	c, ok := b.ordered(actual, expected)
+	if !ok {
+		return b.done(false)
+	}
+	ok = accept(c)
+	if !ok {
+		b.error(actual, expected, "", relation)
+	}
+	return b.done(ok)
+}
+
+var timeType = reflect.TypeFor[time.Time]()
+
+// ordered compares actual to expected like cmp.Compare. The result is false,
+// and the problem is reported, if they cannot be ordered.
+func (b *Bee) ordered(actual, expected any) (int, bool) {
+	b.tb.Helper()
+	actualValue := reflect.ValueOf(actual)
+	expectedValue := reflect.ValueOf(expected)
+	switch {
+	case !actualValue.IsValid():
+		b.errorType(actual, "cannot be ordered")
+		return 0, false
+	case !expectedValue.IsValid():
+		b.errorType(expected, "cannot be ordered")
+		return 0, false
+	case actualValue.Type() != expectedValue.Type():
+		b.errorNotEquals(actualValue.Type(), expectedValue.Type(), "")
+		return 0, false
+	}
+	if actualValue.Type() == timeType {
+		return actual.(time.Time).Compare(expected.(time.Time)), true
+	}
+	switch actualValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(actualValue.Int(), expectedValue.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(actualValue.Uint(), expectedValue.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(actualValue.Float()) || math.IsNaN(expectedValue.Float()) {
+			b.errorNaN()
+			return 0, false
+		}
+		return cmp.Compare(actualValue.Float(), expectedValue.Float()), true
+	case reflect.String:
+		return cmp.Compare(actualValue.String(), expectedValue.String()), true
+	}
+	b.errorType(actual, "cannot be ordered")
+	return 0, false
+}
+
+// errorNaN reports that NaN cannot be ordered.
+func (b *Bee) errorNaN() {
+	b.tb.Helper()
+	b.errorf("", "%s cannot be ordered", b.cfg.actualTextStyle.Render("NaN"))
+}
+
+func zero(value any) any {
+	v := reflect.ValueOf(value)
+	if !v.IsValid() {
+		return nil
+	}
+	return reflect.Zero(v.Type()).Interface()
+}
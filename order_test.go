@@ -0,0 +1,147 @@
+package bee_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/danielrenes/bee"
+)
+
+func TestOrder(t *testing.T) {
+	noon := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		assert  func(b *bee.Bee) bool
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			assert:  func(b *bee.Bee) bool { return b.Greater(2, 1) && b.Greater(uint8(2), uint8(1)) && b.Greater("b", "a") },
+			wantErr: false,
+			errMsg:  "",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Greater(1, 1) },
+			wantErr: true,
+			errMsg:  "1 <= 1",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.GreaterOrEqual(1.5, 1.5) },
+			wantErr: false,
+			errMsg:  "",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.GreaterOrEqual(1.25, 1.5) },
+			wantErr: true,
+			errMsg:  "1.25 < 1.5",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Less(time.Second, time.Minute) },
+			wantErr: false,
+			errMsg:  "",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Less(time.Minute, time.Second, "timeout") },
+			wantErr: true,
+			errMsg:  "1m0s >= 1s: timeout",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.LessOrEqual(noon, noon) },
+			wantErr: false,
+			errMsg:  "",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.LessOrEqual(noon.Add(time.Hour), noon) },
+			wantErr: true,
+			errMsg:  "2024-01-01 13:00:00 +0000 UTC > 2024-01-01 12:00:00 +0000 UTC",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Less("abc", "abb") },
+			wantErr: true,
+			errMsg:  "abc >= abb",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Greater(1, 1.0) },
+			wantErr: true,
+			errMsg:  "int != float64",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Greater(nil, 1) },
+			wantErr: true,
+			errMsg:  "<nil> cannot be ordered",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Less(1, nil) },
+			wantErr: true,
+			errMsg:  "<nil> cannot be ordered",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Positive(nil) },
+			wantErr: true,
+			errMsg:  "<nil> cannot be ordered",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Greater([]int{2}, []int{1}) },
+			wantErr: true,
+			errMsg:  "[]int cannot be ordered",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Greater(math.NaN(), 1.0) },
+			wantErr: true,
+			errMsg:  "NaN cannot be ordered",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Positive(1) && b.Positive(0.5) && b.Positive(time.Second) },
+			wantErr: false,
+			errMsg:  "",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Positive(0) },
+			wantErr: true,
+			errMsg:  "0 <= 0",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Negative(int8(-1)) },
+			wantErr: false,
+			errMsg:  "",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Negative(uint(1)) },
+			wantErr: true,
+			errMsg:  "1 >= 0",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.InRange(3, 1, 5) && b.InRange(1, 1, 5) && b.InRange(5, 1, 5) },
+			wantErr: false,
+			errMsg:  "",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.InRange(6, 1, 5) },
+			wantErr: true,
+			errMsg:  "6 ∉ [1, 5]",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.InRange("a", "b", "d", "name") },
+			wantErr: true,
+			errMsg:  "a ∉ [b, d]: name",
+		},
+	}
+
+	for _, test := range tests {
+		mt := &mockT{T: t}
+		ok := test.assert(bee.New(mt, bee.NoColor()))
+		if ok == test.wantErr {
+			t.Errorf("%v == %v", ok, test.wantErr)
+		}
+		if mt.wasErr != test.wantErr {
+			if test.wantErr {
+				t.Error("expected error")
+			} else {
+				t.Error("expected no error")
+			}
+		}
+		if mt.errMsg != test.errMsg {
+			t.Errorf("%q != %q", mt.errMsg, test.errMsg)
+		}
+	}
+}
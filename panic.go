@@ -0,0 +1,93 @@
+package bee
+
+import (
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"strings"
+)
+
+const noPanic = "<no panic>"
+
+func (b *Bee) Panics(fn func(), msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	_, panicked, _ := catch(fn)
+	if !panicked {
+		b.errorLine(noPanic, "<panic>", "", "!=")
+	}
+	return b.done(panicked)
+}
+
+func (b *Bee) NotPanics(fn func(), msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	recovered, panicked, stack := catch(fn)
+	if panicked {
+		b.errorLine(b.formatPanic(recovered), noPanic, "", "!=")
+		b.logColumns(b.formatPanic(recovered)+"\n\n"+stack, noPanic)
+	}
+	return b.done(!panicked)
+}
+
+// PanicsWith checks that fn panics with a value equal to expected.
+func (b *Bee) PanicsWith(fn func(), expected any, msgAndArgs ...any) bool {
+	b.tb.Helper()
+	b = b.annotate(msgAndArgs)
+	recovered, panicked, stack := catch(fn)
+	if !panicked {
+		b.errorLine(noPanic, b.formatPanic(expected), "", "!=")
+		return b.done(false)
+	}
+	ok := b.newComparison(false).equals(reflect.ValueOf(recovered), reflect.ValueOf(expected), "recover()")
+	if !ok {
+		b.logColumns(b.formatPanic(recovered)+"\n\n"+stack, b.formatPanic(expected))
+	}
+	return b.done(ok)
+}
+
+// catch calls fn and recovers the value it panics with, if any, together
+// with the stack of the panicking goroutine.
+func catch(fn func()) (recovered any, panicked bool, stack string) {
+	defer func() {
+		if panicked {
+			recovered = recover()
+			stack = trimStack(string(debug.Stack()))
+		}
+	}()
+	panicked = true
+	fn()
+	panicked = false
+	return
+}
+
+func (b *Bee) formatPanic(value any) string {
+	return fmt.Sprintf("panic(%s)", b.format(value, false))
+}
+
+// pkgPrefix is the prefix of the functions of this package in stack traces.
+var pkgPrefix = reflect.TypeFor[Bee]().PkgPath() + "."
+
+// trimStack keeps the frames of a stack trace from where the panic happened
+// until the function passed to bee, and drops the frames of the runtime.
+func trimStack(stack string) string {
+	lines := strings.Split(strings.TrimSpace(stack), "\n")
+	start := len(lines)
+	for i, line := range lines {
+		if strings.HasPrefix(line, "panic(") {
+			start = i + 2
+			break
+		}
+	}
+	for start+1 < len(lines) && strings.HasPrefix(lines[start], "runtime.") {
+		start += 2
+	}
+	end := len(lines)
+	for i := start; i < len(lines); i++ {
+		if strings.HasPrefix(lines[i], pkgPrefix) {
+			end = i
+			break
+		}
+	}
+	return strings.Join(lines[min(start, end):end], "\n")
+}
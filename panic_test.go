@@ -0,0 +1,121 @@
+package bee_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/danielrenes/bee"
+)
+
+type panicError struct {
+	Code int
+}
+
+func TestPanics(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		assert  func(b *bee.Bee) bool
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			assert:  func(b *bee.Bee) bool { return b.Panics(func() { panic("boom") }) },
+			wantErr: false,
+			errMsg:  "",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Panics(func() {}) },
+			wantErr: true,
+			errMsg:  "<no panic> != <panic>",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.NotPanics(func() {}) },
+			wantErr: false,
+			errMsg:  "",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.NotPanics(func() { panic("boom") }) },
+			wantErr: true,
+			errMsg:  "panic(boom) != <no panic>",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.PanicsWith(func() { panic("boom") }, "boom") },
+			wantErr: false,
+			errMsg:  "",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.PanicsWith(func() { panic("oops") }, "boom") },
+			wantErr: true,
+			errMsg:  "oops != boom (recover())",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.PanicsWith(func() { panic(panicError{Code: 1}) }, panicError{Code: 2}) },
+			wantErr: true,
+			errMsg:  "1 != 2 (recover().Code)",
+		},
+		{
+			assert: func(b *bee.Bee) bool {
+				return b.PanicsWith(func() { panic(event{At: now}) }, event{At: now.Round(0)})
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.PanicsWith(func() {}, errors.New("boom")) },
+			wantErr: true,
+			errMsg:  "<no panic> != panic(boom)",
+		},
+		{
+			assert:  func(b *bee.Bee) bool { return b.Panics(func() {}, "parse %q", "x") },
+			wantErr: true,
+			errMsg:  `<no panic> != <panic>: parse "x"`,
+		},
+	}
+
+	for _, test := range tests {
+		mockT := &mockT{T: t}
+		ok := test.assert(bee.New(mockT, bee.NoColor()))
+		if ok == test.wantErr {
+			t.Errorf("%v == %v", ok, test.wantErr)
+		}
+		if mockT.wasErr != test.wantErr {
+			if test.wantErr {
+				t.Error("expected error")
+			} else {
+				t.Error("expected no error")
+			}
+		}
+		if mockT.errMsg != test.errMsg {
+			t.Errorf("%q != %q", mockT.errMsg, test.errMsg)
+		}
+	}
+}
+
+func explode() {
+	var m map[string]int
+	m["a"] = 1
+}
+
+func TestPanicStack(t *testing.T) {
+	mockT := &mockT{T: t}
+	bee.New(mockT, bee.NoColor(), bee.ColumnWidth(80)).NotPanics(explode)
+	if !mockT.wasLog {
+		t.Fatal("expected log")
+	}
+	lines := strings.Split(mockT.logMsg, "\n")
+	first := strings.TrimSpace(lines[1])
+	want := "panic(assignment to entry in nil map)"
+	if !strings.HasPrefix(first, want) || !strings.HasSuffix(first, "<no panic>") {
+		t.Errorf("%q does not start with %q", first, want)
+	}
+	if !strings.Contains(mockT.logMsg, "bee_test.explode()") {
+		t.Errorf("%q does not contain the panicking function", mockT.logMsg)
+	}
+	for _, frame := range []string{"runtime.", "runtime/debug", "bee.catch", "testing.tRunner"} {
+		if strings.Contains(mockT.logMsg, frame) {
+			t.Errorf("%q contains %q", mockT.logMsg, frame)
+		}
+	}
+}
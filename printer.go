@@ -0,0 +1,181 @@
+package bee
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// format renders value for failure messages in a Go-syntax-like notation,
+// on a single line or, if indent is set, with one element per line.
+// Top-level strings are rendered as they are, without quotes.
+func (b *Bee) format(value any, indent bool) string {
+	v, ok := value.(reflect.Value)
+	if !ok {
+		v = reflect.ValueOf(value)
+	}
+	if v.Kind() == reflect.String {
+		return v.String()
+	}
+	p := &printer{
+		indent:   indent,
+		maxDepth: b.cfg.maxDepth,
+		stack:    map[ref]bool{},
+	}
+	p.print(v, 0, true)
+	return p.sb.String()
+}
+
+// formatKey renders a map key, quoting it if it is a string.
+func (b *Bee) formatKey(key reflect.Value) string {
+	p := &printer{maxDepth: b.cfg.maxDepth, stack: map[ref]bool{}}
+	p.print(key, 0, false)
+	return p.sb.String()
+}
+
+type printer struct {
+	sb       strings.Builder
+	indent   bool
+	maxDepth int
+	// stack holds the references that are being printed on the current
+	// path, to mark where a value refers back to one of its parents.
+	stack map[ref]bool
+}
+
+// print renders v at the given nesting depth. The type of composite values
+// is omitted unless typed is set, as in Go composite literals where the
+// element types are implied.
+func (p *printer) print(v reflect.Value, depth int, typed bool) {
+	if !v.IsValid() || isNilValue(v) {
+		p.nil(v, typed)
+		return
+	}
+	if s, ok := stringer(v); ok {
+		p.sb.WriteString(s)
+		return
+	}
+	if r, ok := refOf(v); ok {
+		if p.stack[r] {
+			p.sb.WriteString("<cycle>")
+			return
+		}
+		p.stack[r] = true
+		defer delete(p.stack, r)
+	}
+	switch v.Kind() {
+	case reflect.String:
+		p.sb.WriteString(strconv.Quote(v.String()))
+	case reflect.Interface:
+		p.print(v.Elem(), depth, true)
+	case reflect.Pointer:
+		p.sb.WriteString("&")
+		p.print(v.Elem(), depth, typed)
+	case reflect.Array, reflect.Slice:
+		p.composite(v.Type(), v.Len(), depth, typed, func(i int) {
+			p.print(v.Index(i), depth+1, false)
+		})
+	case reflect.Map:
+		keys := sortedKeys(v)
+		p.composite(v.Type(), len(keys), depth, typed, func(i int) {
+			p.print(keys[i], depth+1, false)
+			p.sb.WriteString(": ")
+			p.print(v.MapIndex(keys[i]), depth+1, false)
+		})
+	case reflect.Struct:
+		p.composite(v.Type(), v.NumField(), depth, typed, func(i int) {
+			p.sb.WriteString(v.Type().Field(i).Name)
+			p.sb.WriteString(": ")
+			if isRedacted(v.Type().Field(i)) {
+				p.sb.WriteString(redacted)
+				return
+			}
+			p.print(v.Field(i), depth+1, true)
+		})
+	case reflect.Chan:
+		fmt.Fprintf(&p.sb, "(%s)(%#x)", v.Type(), v.Pointer())
+	case reflect.Func:
+		p.sb.WriteString(funcName(v))
+	case reflect.UnsafePointer:
+		fmt.Fprintf(&p.sb, "unsafe.Pointer(%#x)", v.Pointer())
+	default:
+		fmt.Fprint(&p.sb, v)
+	}
+}
+
+// composite renders the n elements of a value of type t between braces,
+// or elides them if the maximum depth is reached.
+func (p *printer) composite(t reflect.Type, n, depth int, typed bool, element func(i int)) {
+	if typed {
+		p.sb.WriteString(t.String())
+	}
+	if n == 0 {
+		p.sb.WriteString("{}")
+		return
+	}
+	if depth >= p.maxDepth {
+		p.sb.WriteString("{...}")
+		return
+	}
+	p.sb.WriteString("{")
+	for i := 0; i < n; i++ {
+		if p.indent {
+			p.sb.WriteString("\n")
+			p.sb.WriteString(strings.Repeat("  ", depth+1))
+		} else if i > 0 {
+			p.sb.WriteString(", ")
+		}
+		element(i)
+		if p.indent {
+			p.sb.WriteString(",")
+		}
+	}
+	if p.indent {
+		p.sb.WriteString("\n")
+		p.sb.WriteString(strings.Repeat("  ", depth))
+	}
+	p.sb.WriteString("}")
+}
+
+// nil renders a nil value with its type if typed is set, like a conversion
+// of nil in Go. Invalid values and nil interfaces have no type to show.
+func (p *printer) nil(v reflect.Value, typed bool) {
+	if !typed || !v.IsValid() || v.Kind() == reflect.Interface {
+		p.sb.WriteString("<nil>")
+		return
+	}
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice:
+		fmt.Fprintf(&p.sb, "%s(nil)", v.Type())
+	default:
+		fmt.Fprintf(&p.sb, "(%s)(nil)", v.Type())
+	}
+}
+
+func isNilValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice, reflect.UnsafePointer:
+		return v.IsNil()
+	}
+	return false
+}
+
+// stringer returns the text of errors and fmt.Stringers, the same way as
+// fmt does for the %v verb. Methods that panic are ignored.
+func stringer(v reflect.Value) (s string, ok bool) {
+	if !v.CanInterface() {
+		return "", false
+	}
+	defer func() {
+		if recover() != nil {
+			s, ok = "", false
+		}
+	}()
+	switch x := v.Interface().(type) {
+	case error:
+		return x.Error(), true
+	case fmt.Stringer:
+		return x.String(), true
+	}
+	return "", false
+}
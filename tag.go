@@ -0,0 +1,70 @@
+package bee
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const redacted = "<redacted>"
+
+// fieldTag holds the options of the bee tag of a struct field:
+//
+//	bee:"-"           the field is not compared
+//	bee:"redact"      the value of the field is masked in failures
+//	bee:"approx=1e-3" the floats in the field are compared with an absolute tolerance
+type fieldTag struct {
+	skip      bool
+	redact    bool
+	approx    bool
+	tolerance float64
+}
+
+func parseTag(field reflect.StructField) (fieldTag, error) {
+	var tag fieldTag
+	value, ok := field.Tag.Lookup("bee")
+	if !ok {
+		return tag, nil
+	}
+	for _, opt := range strings.Split(value, ",") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(opt), "=")
+		switch name {
+		case "-":
+			tag.skip = true
+		case "redact":
+			tag.redact = true
+		case "approx":
+			eps, err := strconv.ParseFloat(arg, 64)
+			if err != nil || eps < 0 {
+				return tag, fmt.Errorf("invalid tolerance %q in tag of field %s", arg, field.Name)
+			}
+			tag.approx, tag.tolerance = true, eps
+		default:
+			return tag, fmt.Errorf("unknown option %q in tag of field %s", name, field.Name)
+		}
+	}
+	return tag, nil
+}
+
+// field returns the comparison of a struct field with the given tag, which
+// shares the state of c.
+func (c *comparison) field(tag fieldTag) *comparison {
+	if !tag.redact && !tag.approx {
+		return c
+	}
+	f := *c
+	f.redact = c.redact || tag.redact
+	if tag.approx {
+		b := *c.b
+		b.cfg.tolerance = tolerance{abs: tag.tolerance}
+		f.b = &b
+	}
+	return &f
+}
+
+// isRedacted reports whether field is masked by its tag.
+func isRedacted(field reflect.StructField) bool {
+	tag, _ := parseTag(field)
+	return tag.redact
+}